@@ -23,8 +23,14 @@ func NewAppDependencies(configPath string) *AppDependencies {
 		configService = config.NewFileConfigService()
 	}
 
+	// Pick how git is accessed; an unreadable config falls back to the exec backend
+	backendName := config.GitBackendExec
+	if cfg, err := configService.Load(); err == nil {
+		backendName = cfg.GitBackend
+	}
+
 	// Create services
-	repoManager := repomanager.NewRepoManager(configService)
+	repoManager := repomanager.NewRepoManager(configService, repomanager.NewBackend(backendName))
 	themeManager := themeService.NewManager(configService)
 
 	// Initialize the repo manager
@@ -30,9 +30,20 @@ func main() {
 	model := ui.CreateInitialModel(deps)
 	program := tea.NewProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion())
 
-	if _, err := program.Run(); err != nil {
+	finalModel, err := program.Run()
+	if err != nil {
 		fmt.Printf("Error running program: %v", err)
 		log.Fatal(err)
 		os.Exit(1)
 	}
+
+	// Cancel background fetches and watches that are still running
+	if m, ok := finalModel.(ui.Model); ok {
+		if m.FetchScheduler != nil {
+			m.FetchScheduler.Stop()
+		}
+		if m.Watcher != nil {
+			m.Watcher.Stop()
+		}
+	}
 }
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"context"
 	"os/exec"
 	"strings"
+	"time"
 )
 
 // Action represents a configurable action with key binding and command.
@@ -16,13 +18,30 @@ type Action struct {
 
 // ExecuteOpenAction executes the configured action with the given path.
 func (a *Action) ExecuteOpenAction(path string) *exec.Cmd {
-	// Replace {path} placeholder in command and args
+	command, args := a.expand(path)
+	return exec.Command(command, args...)
+}
+
+// ExecuteActionContext returns the configured action for the given path as a
+// command that is killed when ctx is done. It runs without a terminal: standard
+// input is the null device, so programs waiting for input read end of file
+// instead of blocking. Once cancelled, output of children that outlive the
+// command is waited for at most two seconds.
+func (a *Action) ExecuteActionContext(ctx context.Context, path string) *exec.Cmd {
+	command, args := a.expand(path)
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Stdin = nil
+	cmd.WaitDelay = 2 * time.Second
+	return cmd
+}
+
+// expand replaces the {path} placeholder in the command and its arguments.
+func (a *Action) expand(path string) (string, []string) {
 	command := strings.ReplaceAll(a.Command, "{path}", path)
 
 	var args []string
 	for _, arg := range a.Args {
 		args = append(args, strings.ReplaceAll(arg, "{path}", path))
 	}
-
-	return exec.Command(command, args...)
+	return command, args
 }
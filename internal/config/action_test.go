@@ -0,0 +1,32 @@
+package config
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestExecuteActionContext(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+
+	// Reading input gets end of file instead of waiting for a terminal
+	action := Action{Command: "sh", Args: []string{"-c", "read line; echo {path}"}}
+	output, err := action.ExecuteActionContext(context.Background(), "/repo").CombinedOutput()
+	if string(output) != "/repo\n" {
+		t.Errorf("output = %q, err = %v", output, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	slow := Action{Command: "sleep", Args: []string{"10"}}
+	if err := slow.ExecuteActionContext(ctx, "/repo").Run(); err == nil {
+		t.Error("expected a cancelled action to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("cancelled action ran for %v", elapsed)
+	}
+}
@@ -0,0 +1,7 @@
+package config
+
+// CleanupConfig controls which branches the cleanup report lists.
+type CleanupConfig struct {
+	StaleDays     int    `yaml:"stale_days"`     // Days without commits after which a branch is reported as stale
+	DefaultBranch string `yaml:"default_branch"` // Branch others must be merged into, detected per repository when empty
+}
@@ -2,17 +2,48 @@
 package config
 
 import (
+	"time"
+
 	"github.com/jarmocluyse/git-dash/internal/theme"
 )
 
 // Config represents the application configuration.
 type Config struct {
-	Title           string      `yaml:"title"`
-	RepositoryPaths []string    `yaml:"repository_paths"`
-	Theme           theme.Theme `yaml:"theme"`
-	Keybindings     Keybindings `yaml:"keybindings"`
+	Title             string         `yaml:"title"`
+	RepositoryPaths   []string       `yaml:"repository_paths"`
+	StatusConcurrency int            `yaml:"status_concurrency"` // Maximum number of repositories refreshed in parallel
+	RefreshInterval   time.Duration  `yaml:"refresh_interval"`   // Time between automatic status refreshes, 0 disables them
+	SortMode          string         `yaml:"sort_mode"`          // Home list order: "config" (default) or "recent"
+	GitBackend        string         `yaml:"git_backend"`        // How git is accessed: "exec" (default) or "native"
+	PullStrategy      string         `yaml:"pull_strategy"`      // How pulls integrate upstream: "ff-only" (default), "rebase" or "merge"
+	Fetch             FetchConfig    `yaml:"fetch"`
+	Watch             WatchConfig    `yaml:"watch"`
+	Cleanup           CleanupConfig  `yaml:"cleanup"`
+	Worktree          WorktreeConfig `yaml:"worktree"`
+	Scan              ScanConfig     `yaml:"scan"`
+	Theme             theme.Theme    `yaml:"theme"`
+	Keybindings       Keybindings    `yaml:"keybindings"`
 }
 
+// Supported values of the git_backend setting.
+const (
+	GitBackendExec   = "exec"   // Spawn the git executable for every command
+	GitBackendNative = "native" // Read repository state in-process, falling back to exec
+)
+
+// Supported values of the pull_strategy setting.
+const (
+	PullStrategyFFOnly = "ff-only" // Only fast-forward, fail when the branches diverged
+	PullStrategyRebase = "rebase"  // Rebase local commits onto the upstream branch
+	PullStrategyMerge  = "merge"   // Merge the upstream branch into the local branch
+)
+
+// Supported values of the sort_mode setting.
+const (
+	SortModeConfig = "config" // Order of repository_paths
+	SortModeRecent = "recent" // Most recent commit first
+)
+
 // NewFileConfigService creates a new file-based config service.
 func NewFileConfigService() ConfigService {
 	return &FileConfigService{}
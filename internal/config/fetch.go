@@ -0,0 +1,32 @@
+package config
+
+import "time"
+
+// FetchConfig controls the optional background fetch scheduler.
+type FetchConfig struct {
+	Enabled     bool          `yaml:"enabled"`     // Whether remotes are fetched in the background
+	Interval    time.Duration `yaml:"interval"`    // Time between two fetch rounds (e.g. "5m")
+	Concurrency int           `yaml:"concurrency"` // Maximum number of repositories fetched in parallel
+	Timeout     time.Duration `yaml:"timeout"`     // Maximum duration of a single repository fetch
+	StaleAfter  time.Duration `yaml:"stale_after"` // Age after which remote state is reported as stale
+}
+
+// DefaultFetchInterval is the time between two fetch rounds when none is configured.
+const DefaultFetchInterval = 5 * time.Minute
+
+// EffectiveInterval returns the configured fetch interval, or DefaultFetchInterval when unset.
+func (f FetchConfig) EffectiveInterval() time.Duration {
+	if f.Interval > 0 {
+		return f.Interval
+	}
+	return DefaultFetchInterval
+}
+
+// StaleThreshold returns the age after which a fetch is considered stale.
+// Defaults to three fetch intervals when not configured.
+func (f FetchConfig) StaleThreshold() time.Duration {
+	if f.StaleAfter > 0 {
+		return f.StaleAfter
+	}
+	return 3 * f.EffectiveInterval()
+}
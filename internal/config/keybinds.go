@@ -1,8 +1,33 @@
 package config
 
+import "os"
+
 // Keybindings holds configuration for key bindings.
 type Keybindings struct {
 	Actions []Action `yaml:"actions"` // List of configurable actions
+	Editor  Action   `yaml:"editor"`  // Action that opens a single file, {path} is the file
+}
+
+// EditorAction returns the editor action, falling back to $VISUAL, $EDITOR and vi
+// when no command is configured.
+func (k *Keybindings) EditorAction() Action {
+	editor := k.Editor
+	if editor.Key == "" {
+		editor.Key = "e"
+	}
+	if len(editor.Args) == 0 {
+		editor.Args = []string{"{path}"}
+	}
+	if editor.Command == "" {
+		editor.Command = os.Getenv("VISUAL")
+	}
+	if editor.Command == "" {
+		editor.Command = os.Getenv("EDITOR")
+	}
+	if editor.Command == "" {
+		editor.Command = "vi"
+	}
+	return editor
 }
 
 // FindActionByKey finds an action by its key binding.
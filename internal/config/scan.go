@@ -0,0 +1,33 @@
+package config
+
+// ScanConfig controls the recursive repository discovery scan.
+type ScanConfig struct {
+	Roots          []string `yaml:"roots"`           // Directories the scan starts from, the home directory when empty
+	MaxDepth       int      `yaml:"max_depth"`       // Directory levels searched below each root
+	Exclude        []string `yaml:"exclude"`         // Directory name globs that are never entered
+	SkipNested     bool     `yaml:"skip_nested"`     // Do not search inside found repositories
+	SkipSubmodules bool     `yaml:"skip_submodules"` // Leave submodules out of the results
+}
+
+// DefaultScanMaxDepth is the number of directory levels searched when none is configured.
+const DefaultScanMaxDepth = 4
+
+// DefaultScanExclude lists dependency directories that hold vendored repositories.
+var DefaultScanExclude = []string{"node_modules", "vendor"}
+
+// EffectiveMaxDepth returns the configured depth, or DefaultScanMaxDepth when unset.
+func (s ScanConfig) EffectiveMaxDepth() int {
+	if s.MaxDepth > 0 {
+		return s.MaxDepth
+	}
+	return DefaultScanMaxDepth
+}
+
+// EffectiveExclude returns the configured exclude globs, or DefaultScanExclude when
+// the setting is missing. An explicitly empty list excludes nothing.
+func (s ScanConfig) EffectiveExclude() []string {
+	if s.Exclude == nil {
+		return DefaultScanExclude
+	}
+	return s.Exclude
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/jarmocluyse/git-dash/internal/theme"
 	"gopkg.in/yaml.v3"
@@ -57,10 +58,43 @@ func (f *FileConfigService) createEmptyConfig() *Config {
 	}
 
 	return &Config{
-		RepositoryPaths: []string{},
-		Theme:           loadedTheme,
+		RepositoryPaths:   []string{},
+		StatusConcurrency: 8,
+		RefreshInterval:   30 * time.Second,
+		SortMode:          SortModeConfig,
+		GitBackend:        GitBackendExec,
+		PullStrategy:      PullStrategyFFOnly,
+		Fetch: FetchConfig{
+			Enabled:     false,
+			Interval:    5 * time.Minute,
+			Concurrency: 4,
+			Timeout:     30 * time.Second,
+		},
+		Watch: WatchConfig{
+			Enabled:        true,
+			Debounce:       DefaultWatchDebounce,
+			MaxDirectories: DefaultWatchMaxDirectories,
+			Ignore:         DefaultWatchIgnore,
+		},
+		Cleanup: CleanupConfig{
+			StaleDays: 90,
+		},
+		Worktree: WorktreeConfig{
+			PathTemplate: DefaultWorktreePathTemplate,
+		},
+		Scan: ScanConfig{
+			MaxDepth: DefaultScanMaxDepth,
+			Exclude:  DefaultScanExclude,
+		},
+		Theme: loadedTheme,
 		Keybindings: Keybindings{
 			Actions: defaultActions,
+			Editor: Action{
+				Name:        "Editor",
+				Key:         "e",
+				Args:        []string{"{path}"},
+				Description: "Open file in editor",
+			},
 		},
 	}
 }
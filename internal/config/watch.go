@@ -0,0 +1,45 @@
+package config
+
+import "time"
+
+// WatchConfig controls live status updates from filesystem events.
+type WatchConfig struct {
+	Enabled        bool          `yaml:"enabled"`         // Whether working trees and git directories are watched
+	Debounce       time.Duration `yaml:"debounce"`        // Quiet period collecting events before a refresh
+	MaxDirectories int           `yaml:"max_directories"` // Maximum working tree directories watched per repository
+	Ignore         []string      `yaml:"ignore"`          // Directory name globs that are never watched
+}
+
+// DefaultWatchDebounce is the quiet period used when none is configured.
+const DefaultWatchDebounce = 300 * time.Millisecond
+
+// DefaultWatchMaxDirectories is the directory limit used when none is configured.
+const DefaultWatchMaxDirectories = 2000
+
+// DefaultWatchIgnore lists directories that are large, generated and rarely tracked.
+var DefaultWatchIgnore = []string{"node_modules", ".venv", "venv", "__pycache__", "target", ".cache"}
+
+// EffectiveDebounce returns the configured debounce, or DefaultWatchDebounce when unset.
+func (w WatchConfig) EffectiveDebounce() time.Duration {
+	if w.Debounce > 0 {
+		return w.Debounce
+	}
+	return DefaultWatchDebounce
+}
+
+// EffectiveMaxDirectories returns the configured limit, or DefaultWatchMaxDirectories when unset.
+func (w WatchConfig) EffectiveMaxDirectories() int {
+	if w.MaxDirectories > 0 {
+		return w.MaxDirectories
+	}
+	return DefaultWatchMaxDirectories
+}
+
+// EffectiveIgnore returns the configured ignore globs, or DefaultWatchIgnore when
+// the setting is missing. An explicitly empty list ignores nothing.
+func (w WatchConfig) EffectiveIgnore() []string {
+	if w.Ignore == nil {
+		return DefaultWatchIgnore
+	}
+	return w.Ignore
+}
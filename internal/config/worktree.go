@@ -0,0 +1,24 @@
+package config
+
+// DefaultWorktreePathTemplate places new worktrees inside the repository directory.
+const DefaultWorktreePathTemplate = "{repo}/{branch}"
+
+// DefaultCloneWorktreePathTemplate places new worktrees of a clone next to it, since
+// inside its working tree they would show up as untracked files.
+const DefaultCloneWorktreePathTemplate = "{parent}/{name}-{branch}"
+
+// WorktreeConfig controls how worktrees are created.
+type WorktreeConfig struct {
+	// PathTemplate proposes the path of a new worktree. {repo} is the repository
+	// path, {parent} its directory, {name} its name and {branch} the branch name.
+	PathTemplate string `yaml:"path_template"`
+}
+
+// PathTemplateFor returns the path template for a bare repository or a clone. A
+// clone left on the default template gets DefaultCloneWorktreePathTemplate.
+func (w WorktreeConfig) PathTemplateFor(bare bool) string {
+	if !bare && (w.PathTemplate == "" || w.PathTemplate == DefaultWorktreePathTemplate) {
+		return DefaultCloneWorktreePathTemplate
+	}
+	return w.PathTemplate
+}
@@ -0,0 +1,135 @@
+package gitnative
+
+import "container/heap"
+
+// maxWalkCommits bounds the history walked for ahead/behind counts.
+// Larger divergences are left to git, which can use its commit-graph.
+const maxWalkCommits = 10000
+
+// Walk flags recording which tips reach a commit.
+const (
+	fromLocal    = 1 << iota // Reachable from the local branch
+	fromUpstream             // Reachable from the upstream branch
+)
+
+// aheadBehind counts the commits only reachable from local and only reachable from upstream.
+// Commits are visited newest first and the walk stops once every pending commit is
+// reachable from both sides. Flags reaching an already walked commit are pushed down
+// to its known ancestors, so commits with equal timestamps are counted correctly.
+func (r *Repository) aheadBehind(local, upstream string) (int, int, error) {
+	w := &historyWalk{
+		repo:    r,
+		flags:   make(map[string]int),
+		parents: make(map[string][]string),
+		queue:   &commitQueue{},
+	}
+
+	if err := w.mark(local, fromLocal); err != nil {
+		return 0, 0, err
+	}
+	if err := w.mark(upstream, fromUpstream); err != nil {
+		return 0, 0, err
+	}
+
+	for w.queue.Len() > 0 && !w.queue.allShared(w.flags) {
+		if len(w.parents) > maxWalkCommits {
+			return 0, 0, ErrUnsupported
+		}
+
+		current := heap.Pop(w.queue).(queuedCommit)
+		for _, parent := range current.commit.Parents {
+			if err := w.mark(parent, w.flags[current.oid]); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+
+	ahead, behind := 0, 0
+	for _, f := range w.flags {
+		switch f {
+		case fromLocal:
+			ahead++
+		case fromUpstream:
+			behind++
+		}
+	}
+	return ahead, behind, nil
+}
+
+// historyWalk is the state of an ahead/behind walk.
+type historyWalk struct {
+	repo    *Repository
+	flags   map[string]int      // Tips reaching each seen commit
+	parents map[string][]string // Parents of every commit read so far
+	queue   *commitQueue        // Commits whose parents are not walked yet
+}
+
+// mark adds flags to a commit. New commits are read and queued, while commits
+// already read pass the flags on to their known ancestors.
+func (w *historyWalk) mark(oid string, flags int) error {
+	if w.flags[oid]|flags == w.flags[oid] {
+		return nil
+	}
+	w.flags[oid] |= flags
+
+	if _, read := w.parents[oid]; read {
+		w.propagate(oid, flags)
+		return nil
+	}
+
+	c, err := w.repo.readCommit(oid)
+	if err != nil {
+		return err
+	}
+	w.parents[oid] = c.Parents
+	heap.Push(w.queue, queuedCommit{oid: oid, commit: c})
+	return nil
+}
+
+// propagate pushes flags down to every already read ancestor of oid.
+func (w *historyWalk) propagate(oid string, flags int) {
+	stack := []string{oid}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, parent := range w.parents[current] {
+			if _, seen := w.flags[parent]; !seen || w.flags[parent]|flags == w.flags[parent] {
+				continue
+			}
+			w.flags[parent] |= flags
+			stack = append(stack, parent)
+		}
+	}
+}
+
+// queuedCommit is a commit waiting to be walked.
+type queuedCommit struct {
+	oid    string
+	commit commit
+}
+
+// commitQueue is a max-heap of commits ordered by committer time.
+type commitQueue []queuedCommit
+
+func (q commitQueue) Len() int           { return len(q) }
+func (q commitQueue) Less(i, j int) bool { return q[i].commit.Time > q[j].commit.Time }
+func (q commitQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *commitQueue) Push(x any)        { *q = append(*q, x.(queuedCommit)) }
+
+func (q *commitQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// allShared reports whether every queued commit is reachable from both tips.
+func (q commitQueue) allShared(flags map[string]int) bool {
+	for _, c := range q {
+		if flags[c.oid] != fromLocal|fromUpstream {
+			return false
+		}
+	}
+	return true
+}
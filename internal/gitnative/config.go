@@ -0,0 +1,152 @@
+package gitnative
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gitConfig holds the merged values of the git configuration files that affect reads.
+// Keys are stored as "section.subsection.key" with section and key lower-cased.
+type gitConfig struct {
+	values map[string]string
+}
+
+// loadConfig merges the system, global and repository configuration in git's precedence order.
+func loadConfig(commonDir string) (*gitConfig, error) {
+	cfg := &gitConfig{values: make(map[string]string)}
+
+	var files []string
+	if os.Getenv("GIT_CONFIG_NOSYSTEM") == "" {
+		files = append(files, "/etc/gitconfig")
+	}
+	files = append(files, globalConfigFiles()...)
+	files = append(files, filepath.Join(commonDir, "config"))
+
+	for _, file := range files {
+		if err := cfg.parseFile(file); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// globalConfigFiles returns the user-level configuration files git reads.
+func globalConfigFiles() []string {
+	if global := os.Getenv("GIT_CONFIG_GLOBAL"); global != "" {
+		return []string{global}
+	}
+
+	home, _ := os.UserHomeDir()
+	xdg := os.Getenv("XDG_CONFIG_HOME")
+	if xdg == "" && home != "" {
+		xdg = filepath.Join(home, ".config")
+	}
+
+	var files []string
+	if xdg != "" {
+		files = append(files, filepath.Join(xdg, "git", "config"))
+	}
+	if home != "" {
+		files = append(files, filepath.Join(home, ".gitconfig"))
+	}
+	return files
+}
+
+// parseFile reads a single configuration file. Missing files are ignored.
+// Includes are not followed, so files using them are reported as unsupported.
+func (c *gitConfig) parseFile(path string) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' {
+			end := strings.LastIndexByte(line, ']')
+			if end < 0 {
+				return ErrUnsupported
+			}
+			section = parseSectionHeader(line[1:end])
+			if strings.HasPrefix(section, "include") {
+				return ErrUnsupported
+			}
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !found {
+			// A key without a value is a boolean true
+			value = "true"
+		}
+		c.values[section+"."+key] = parseValue(value)
+	}
+	return scanner.Err()
+}
+
+// parseSectionHeader turns `branch "main"` into "branch.main" and `core` into "core".
+func parseSectionHeader(header string) string {
+	name, subsection, found := strings.Cut(header, " ")
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !found {
+		// Legacy [section.subsection] syntax
+		return name
+	}
+	subsection = strings.TrimSpace(subsection)
+	subsection = strings.TrimSuffix(strings.TrimPrefix(subsection, `"`), `"`)
+	return name + "." + subsection
+}
+
+// parseValue strips comments and quotes from a raw configuration value.
+func parseValue(raw string) string {
+	var value strings.Builder
+	inQuotes := false
+	for i := 0; i < len(raw); i++ {
+		ch := raw[i]
+		switch {
+		case ch == '"':
+			inQuotes = !inQuotes
+		case ch == '\\' && i+1 < len(raw):
+			i++
+			value.WriteByte(raw[i])
+		case (ch == '#' || ch == ';') && !inQuotes:
+			return strings.TrimSpace(value.String())
+		default:
+			value.WriteByte(ch)
+		}
+	}
+	return strings.TrimSpace(value.String())
+}
+
+// get returns the value of a key and whether it is set.
+func (c *gitConfig) get(key string) (string, bool) {
+	value, ok := c.values[key]
+	return value, ok
+}
+
+// getBool returns a boolean value, or def when the key is not set.
+func (c *gitConfig) getBool(key string, def bool) bool {
+	value, ok := c.values[key]
+	if !ok {
+		return def
+	}
+	switch strings.ToLower(value) {
+	case "true", "yes", "on", "1":
+		return true
+	default:
+		return false
+	}
+}
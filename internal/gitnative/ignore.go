@@ -0,0 +1,258 @@
+package gitnative
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"strings"
+)
+
+// ignorePattern is a single line of a gitignore file.
+type ignorePattern struct {
+	pattern  string
+	base     string // Directory the pattern is relative to, "" for the worktree root
+	negate   bool   // Pattern starts with "!"
+	dirOnly  bool   // Pattern ends with "/"
+	anchored bool   // Pattern contains a slash and matches relative to base
+}
+
+// ignoreList holds patterns in increasing order of precedence.
+type ignoreList struct {
+	patterns []ignorePattern
+}
+
+// addFile appends the patterns of a gitignore file. Missing files are ignored.
+func (l *ignoreList) addFile(file, base string) error {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if p, ok := parseIgnoreLine(scanner.Text(), base); ok {
+			l.patterns = append(l.patterns, p)
+		}
+	}
+	return scanner.Err()
+}
+
+// with returns a copy of the list extended by the .gitignore of a directory.
+func (l *ignoreList) with(file, base string) (*ignoreList, error) {
+	extended := &ignoreList{patterns: l.patterns[:len(l.patterns):len(l.patterns)]}
+	if err := extended.addFile(file, base); err != nil {
+		return nil, err
+	}
+	return extended, nil
+}
+
+// parseIgnoreLine parses a single gitignore line.
+func parseIgnoreLine(line, base string) (ignorePattern, bool) {
+	line = strings.TrimSuffix(line, "\r")
+	line = trimTrailingSpaces(line)
+	if line == "" || line[0] == '#' {
+		return ignorePattern{}, false
+	}
+
+	p := ignorePattern{base: base}
+	if line[0] == '!' {
+		p.negate = true
+		line = line[1:]
+	} else if line[0] == '\\' && len(line) > 1 && (line[1] == '#' || line[1] == '!') {
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return ignorePattern{}, false
+	}
+
+	p.pattern = line
+	return p, true
+}
+
+// trimTrailingSpaces removes trailing spaces unless they are escaped with a backslash.
+func trimTrailingSpaces(line string) string {
+	end := len(line)
+	for end > 0 && line[end-1] == ' ' {
+		if end > 1 && line[end-2] == '\\' {
+			break
+		}
+		end--
+	}
+	return line[:end]
+}
+
+// ignored reports whether the worktree-relative path is excluded.
+// The last matching pattern decides, so negations can re-include paths.
+func (l *ignoreList) ignored(rel string, isDir bool) bool {
+	for i := len(l.patterns) - 1; i >= 0; i-- {
+		p := l.patterns[i]
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.matches(rel) {
+			return !p.negate
+		}
+	}
+	return false
+}
+
+// matches reports whether the pattern matches a worktree-relative path.
+func (p ignorePattern) matches(rel string) bool {
+	if p.base != "" {
+		var found bool
+		rel, found = strings.CutPrefix(rel, p.base+"/")
+		if !found {
+			return false
+		}
+	}
+
+	if !p.anchored {
+		return wildmatch(p.pattern, path.Base(rel))
+	}
+	return wildmatch(p.pattern, rel)
+}
+
+// wildmatch matches a path against a gitignore glob.
+// "*" and "?" do not match "/", while "**" between slashes matches any number of directories.
+func wildmatch(pattern, name string) bool {
+	return matchFrom(pattern, 0, name)
+}
+
+// matchFrom matches pattern[pi:] against name. The position is needed to tell
+// whether a "**" starts at a path component boundary.
+func matchFrom(pattern string, pi int, name string) bool {
+	for pi < len(pattern) {
+		c := pattern[pi]
+		switch c {
+		case '*':
+			atBoundary := pi == 0 || pattern[pi-1] == '/'
+			if strings.HasPrefix(pattern[pi:], "**") && atBoundary && (pi+2 == len(pattern) || pattern[pi+2] == '/') {
+				if pi+2 == len(pattern) {
+					// Trailing "/**" matches everything below
+					return true
+				}
+				// "**/" matches zero or more leading directories
+				rest := pi + 3
+				for {
+					if matchFrom(pattern, rest, name) {
+						return true
+					}
+					slash := strings.IndexByte(name, '/')
+					if slash < 0 {
+						return false
+					}
+					name = name[slash+1:]
+				}
+			}
+
+			for pi < len(pattern) && pattern[pi] == '*' {
+				pi++
+			}
+			if pi == len(pattern) {
+				return !strings.Contains(name, "/")
+			}
+			for i := 0; i <= len(name); i++ {
+				if matchFrom(pattern, pi, name[i:]) {
+					return true
+				}
+				if i < len(name) && name[i] == '/' {
+					return false
+				}
+			}
+			return false
+		case '?':
+			if name == "" || name[0] == '/' {
+				return false
+			}
+			pi++
+			name = name[1:]
+		case '[':
+			if name == "" || name[0] == '/' {
+				return false
+			}
+			matched, next, ok := matchClass(pattern, pi, name[0])
+			if !ok {
+				// An unterminated class matches a literal "["
+				if name[0] != '[' {
+					return false
+				}
+				pi++
+			} else {
+				if !matched {
+					return false
+				}
+				pi = next
+			}
+			name = name[1:]
+		case '\\':
+			if pi+1 < len(pattern) {
+				pi++
+				c = pattern[pi]
+			}
+			fallthrough
+		default:
+			if name == "" || name[0] != c {
+				return false
+			}
+			pi++
+			name = name[1:]
+		}
+	}
+	return name == ""
+}
+
+// matchClass matches a single byte against the bracket expression starting at pattern[pi].
+// It returns whether the byte matched, the position after the class and whether the class was valid.
+func matchClass(pattern string, pi int, b byte) (bool, int, bool) {
+	i := pi + 1
+	negate := false
+	if i < len(pattern) && (pattern[i] == '!' || pattern[i] == '^') {
+		negate = true
+		i++
+	}
+
+	matched := false
+	first := true
+	for i < len(pattern) && (first || pattern[i] != ']') {
+		first = false
+
+		lo := pattern[i]
+		if lo == '\\' && i+1 < len(pattern) {
+			i++
+			lo = pattern[i]
+		}
+		i++
+
+		hi := lo
+		if i+1 < len(pattern) && pattern[i] == '-' && pattern[i+1] != ']' {
+			hi = pattern[i+1]
+			if hi == '\\' && i+2 < len(pattern) {
+				i++
+				hi = pattern[i+1]
+			}
+			i += 2
+		}
+
+		if lo <= b && b <= hi {
+			matched = true
+		}
+	}
+
+	if i >= len(pattern) {
+		return false, 0, false
+	}
+	return matched != negate, i + 1, true
+}
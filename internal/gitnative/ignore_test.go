@@ -0,0 +1,71 @@
+package gitnative
+
+import "testing"
+
+func TestWildmatch(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"*.log", "debug.log", true},
+		{"*.log", "dir/debug.log", false},
+		{"debug?.log", "debug1.log", true},
+		{"debug[0-9].log", "debuga.log", false},
+		{"debug[!0-9].log", "debuga.log", true},
+		{"build/*.o", "build/main.o", true},
+		{"build/*.o", "build/sub/main.o", false},
+		{"**/vendor", "vendor", true},
+		{"**/vendor", "a/b/vendor", true},
+		{"docs/**", "docs/a/b.md", true},
+		{"a/**/b", "a/b", true},
+		{"a/**/b", "a/x/y/b", true},
+		{"a/**/b", "a/x/c", false},
+		{`\#notes`, "#notes", true},
+		{"[unterminated", "[unterminated", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			if result := wildmatch(tt.pattern, tt.name); result != tt.expected {
+				t.Errorf("wildmatch(%q, %q) = %v, expected %v", tt.pattern, tt.name, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIgnoreList(t *testing.T) {
+	list := &ignoreList{}
+	for _, line := range []string{"# comment", "*.log", "!keep.log", "build/", "/root-only"} {
+		if p, ok := parseIgnoreLine(line, ""); ok {
+			list.patterns = append(list.patterns, p)
+		}
+	}
+	if p, ok := parseIgnoreLine("*.tmp", "sub"); ok {
+		list.patterns = append(list.patterns, p)
+	}
+
+	tests := []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{"debug.log", false, true},
+		{"dir/debug.log", false, true},
+		{"keep.log", false, false},
+		{"build", true, true},
+		{"build", false, false},
+		{"root-only", false, true},
+		{"dir/root-only", false, false},
+		{"sub/a.tmp", false, true},
+		{"other/a.tmp", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if result := list.ignored(tt.path, tt.isDir); result != tt.expected {
+				t.Errorf("ignored(%q, %v) = %v, expected %v", tt.path, tt.isDir, result, tt.expected)
+			}
+		})
+	}
+}
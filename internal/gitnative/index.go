@@ -0,0 +1,164 @@
+package gitnative
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// Index entry flags.
+const (
+	flagAssumeValid = 0x8000
+	flagExtended    = 0x4000
+	flagStageMask   = 0x3000
+	flagNameMask    = 0x0fff
+)
+
+// indexEntry is a single stage-0 entry of the index.
+type indexEntry struct {
+	Path      string
+	Mode      uint32
+	Oid       string
+	Size      uint32
+	MtimeSec  uint32
+	MtimeNsec uint32
+}
+
+// index is the parsed content of the index file.
+type index struct {
+	Entries  []indexEntry
+	Tree     string // Root tree id from the cache-tree extension, empty when invalid
+	MtimeSec int64  // Modification time of the index file, for racy entry detection
+	MtimeNs  int64
+}
+
+// readIndex parses the index of the repository's worktree.
+// A missing index is treated as empty.
+func (r *Repository) readIndex() (*index, error) {
+	path := filepath.Join(r.GitDir, "index")
+
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return &index{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := parseIndex(data)
+	if err != nil {
+		return nil, err
+	}
+	idx.MtimeSec = info.ModTime().Unix()
+	idx.MtimeNs = int64(info.ModTime().Nanosecond())
+	return idx, nil
+}
+
+// parseIndex parses index versions 2 and 3.
+func parseIndex(data []byte) (*index, error) {
+	if len(data) < 12+20 || string(data[:4]) != "DIRC" {
+		return nil, fmt.Errorf("invalid index header")
+	}
+
+	version := binary.BigEndian.Uint32(data[4:8])
+	if version != 2 && version != 3 {
+		// Version 4 compresses paths and is not implemented
+		return nil, ErrUnsupported
+	}
+
+	count := int(binary.BigEndian.Uint32(data[8:12]))
+	body := data[:len(data)-20] // Strip the trailing checksum
+	pos := 12
+
+	idx := &index{Entries: make([]indexEntry, 0, count)}
+	for i := 0; i < count; i++ {
+		if pos+62 > len(body) {
+			return nil, fmt.Errorf("truncated index entry")
+		}
+		entry := body[pos:]
+
+		flags := binary.BigEndian.Uint16(entry[60:62])
+		if flags&(flagAssumeValid|flagStageMask) != 0 {
+			// Unmerged and assume-unchanged entries need git's own handling
+			return nil, ErrUnsupported
+		}
+
+		headerSize := 62
+		if flags&flagExtended != 0 {
+			// Extended flags mark skip-worktree and intent-to-add entries
+			return nil, ErrUnsupported
+		}
+
+		nameLen := int(flags & flagNameMask)
+		if nameLen == flagNameMask {
+			nameLen = bytes.IndexByte(entry[headerSize:], 0)
+		}
+		if nameLen < 0 || headerSize+nameLen > len(entry) {
+			return nil, fmt.Errorf("truncated index entry name")
+		}
+
+		idx.Entries = append(idx.Entries, indexEntry{
+			Path:      string(entry[headerSize : headerSize+nameLen]),
+			MtimeSec:  binary.BigEndian.Uint32(entry[8:12]),
+			MtimeNsec: binary.BigEndian.Uint32(entry[12:16]),
+			Mode:      binary.BigEndian.Uint32(entry[24:28]),
+			Size:      binary.BigEndian.Uint32(entry[36:40]),
+			Oid:       hex.EncodeToString(entry[40:60]),
+		})
+
+		// Entries are NUL padded to a multiple of eight bytes
+		pos += (headerSize + nameLen + 8) &^ 7
+	}
+
+	for pos+8 <= len(body) {
+		signature := string(body[pos : pos+4])
+		size := int(binary.BigEndian.Uint32(body[pos+4 : pos+8]))
+		pos += 8
+		if pos+size > len(body) {
+			return nil, fmt.Errorf("truncated index extension %q", signature)
+		}
+
+		switch signature {
+		case "TREE":
+			idx.Tree = parseRootTree(body[pos : pos+size])
+		case "link", "sdir":
+			// Split and sparse indexes are not implemented
+			return nil, ErrUnsupported
+		}
+		pos += size
+	}
+
+	return idx, nil
+}
+
+// parseRootTree returns the root tree id of a cache-tree extension, or "" when invalidated.
+func parseRootTree(data []byte) string {
+	// The root entry has an empty path: "\0<entry count> <subtrees>\n<oid>"
+	if len(data) == 0 || data[0] != 0 {
+		return ""
+	}
+
+	end := bytes.IndexByte(data, '\n')
+	if end < 0 {
+		return ""
+	}
+
+	fields := bytes.Fields(data[1:end])
+	if len(fields) != 2 {
+		return ""
+	}
+	entryCount, err := strconv.Atoi(string(fields[0]))
+	if err != nil || entryCount < 0 || len(data) < end+1+20 {
+		return ""
+	}
+	return hex.EncodeToString(data[end+1 : end+1+20])
+}
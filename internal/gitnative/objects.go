@@ -0,0 +1,436 @@
+package gitnative
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Object types as stored in pack files.
+const (
+	objCommit   = 1
+	objTree     = 2
+	objBlob     = 3
+	objTag      = 4
+	objOfsDelta = 6
+	objRefDelta = 7
+)
+
+// maxDeltaDepth bounds delta chains so corrupt packs cannot recurse forever.
+const maxDeltaDepth = 64
+
+// objectStore reads loose and packed objects from an objects directory.
+type objectStore struct {
+	dir string
+
+	once  sync.Once
+	packs []*packFile
+	err   error
+}
+
+// packFile is an opened pack together with its version 2 index.
+type packFile struct {
+	pack   *os.File
+	index  *os.File
+	fanout [256]uint32
+}
+
+// newObjectStore creates a store for the given objects directory. Packs are opened lazily.
+func newObjectStore(dir string) *objectStore {
+	return &objectStore{dir: dir}
+}
+
+// close releases all opened pack files.
+func (s *objectStore) close() {
+	for _, p := range s.packs {
+		p.pack.Close()
+		p.index.Close()
+	}
+	s.packs = nil
+}
+
+// read returns the type and content of an object.
+func (s *objectStore) read(oid string) (int, []byte, error) {
+	return s.readDepth(oid, 0)
+}
+
+// readDepth reads an object while tracking the delta chain depth.
+func (s *objectStore) readDepth(oid string, depth int) (int, []byte, error) {
+	if depth > maxDeltaDepth {
+		return 0, nil, ErrUnsupported
+	}
+
+	objType, data, err := s.readLoose(oid)
+	if err == nil || !os.IsNotExist(err) {
+		return objType, data, err
+	}
+
+	s.once.Do(func() { s.err = s.openPacks() })
+	if s.err != nil {
+		return 0, nil, s.err
+	}
+
+	raw, err := hex.DecodeString(oid)
+	if err != nil || len(raw) != 20 {
+		return 0, nil, fmt.Errorf("invalid object id %q", oid)
+	}
+
+	for _, p := range s.packs {
+		offset, found, err := p.find(raw)
+		if err != nil {
+			return 0, nil, err
+		}
+		if found {
+			return s.readPacked(p, offset, depth)
+		}
+	}
+
+	// The object may live in an alternate object store
+	return 0, nil, ErrUnsupported
+}
+
+// readLoose reads a zlib-compressed loose object.
+func (s *objectStore) readLoose(oid string) (int, []byte, error) {
+	if len(oid) < 3 {
+		return 0, nil, os.ErrNotExist
+	}
+
+	file, err := os.Open(filepath.Join(s.dir, oid[:2], oid[2:]))
+	if err != nil {
+		return 0, nil, err
+	}
+	defer file.Close()
+
+	reader, err := zlib.NewReader(file)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	header, data, found := bytes.Cut(content, []byte{0})
+	if !found {
+		return 0, nil, fmt.Errorf("corrupt loose object %s", oid)
+	}
+	typeName, _, _ := strings.Cut(string(header), " ")
+
+	switch typeName {
+	case "commit":
+		return objCommit, data, nil
+	case "tree":
+		return objTree, data, nil
+	case "blob":
+		return objBlob, data, nil
+	case "tag":
+		return objTag, data, nil
+	default:
+		return 0, nil, fmt.Errorf("unknown object type %q", typeName)
+	}
+}
+
+// openPacks opens every pack with a version 2 index in the store.
+func (s *objectStore) openPacks() error {
+	indexes, err := filepath.Glob(filepath.Join(s.dir, "pack", "*.idx"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(indexes)
+
+	for _, indexPath := range indexes {
+		p, err := openPackFile(indexPath)
+		if err != nil {
+			return err
+		}
+		s.packs = append(s.packs, p)
+	}
+	return nil
+}
+
+// openPackFile opens an index and its pack and loads the fanout table.
+func openPackFile(indexPath string) (*packFile, error) {
+	index, err := os.Open(indexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 8+256*4)
+	if _, err := index.ReadAt(header, 0); err != nil {
+		index.Close()
+		return nil, err
+	}
+	if !bytes.Equal(header[:4], []byte{0xff, 't', 'O', 'c'}) || binary.BigEndian.Uint32(header[4:8]) != 2 {
+		index.Close()
+		return nil, ErrUnsupported
+	}
+
+	pack, err := os.Open(strings.TrimSuffix(indexPath, ".idx") + ".pack")
+	if err != nil {
+		index.Close()
+		return nil, err
+	}
+
+	p := &packFile{pack: pack, index: index}
+	for i := range p.fanout {
+		p.fanout[i] = binary.BigEndian.Uint32(header[8+i*4:])
+	}
+	return p, nil
+}
+
+// find looks an object id up in the index and returns its offset in the pack.
+func (p *packFile) find(oid []byte) (int64, bool, error) {
+	count := int64(p.fanout[255])
+	lo := int64(0)
+	if oid[0] > 0 {
+		lo = int64(p.fanout[oid[0]-1])
+	}
+	hi := int64(p.fanout[oid[0]])
+
+	const namesStart = 8 + 256*4
+	name := make([]byte, 20)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if _, err := p.index.ReadAt(name, namesStart+mid*20); err != nil {
+			return 0, false, err
+		}
+
+		switch cmp := bytes.Compare(name, oid); {
+		case cmp == 0:
+			return p.offset(mid, count)
+		case cmp < 0:
+			lo = mid + 1
+		default:
+			hi = mid
+		}
+	}
+	return 0, false, nil
+}
+
+// offset returns the pack offset of the object at position i of the index.
+func (p *packFile) offset(i, count int64) (int64, bool, error) {
+	offsetsStart := 8 + 256*4 + count*24
+
+	buf := make([]byte, 8)
+	if _, err := p.index.ReadAt(buf[:4], offsetsStart+i*4); err != nil {
+		return 0, false, err
+	}
+
+	offset := binary.BigEndian.Uint32(buf[:4])
+	if offset&0x80000000 == 0 {
+		return int64(offset), true, nil
+	}
+
+	// Offsets beyond 2 GiB are stored in the large offset table
+	largeStart := offsetsStart + count*4
+	if _, err := p.index.ReadAt(buf, largeStart+int64(offset&0x7fffffff)*8); err != nil {
+		return 0, false, err
+	}
+	return int64(binary.BigEndian.Uint64(buf)), true, nil
+}
+
+// readPacked reads and, if needed, undeltifies the object at offset.
+func (s *objectStore) readPacked(p *packFile, offset int64, depth int) (int, []byte, error) {
+	reader := bufio.NewReader(io.NewSectionReader(p.pack, offset, 1<<62))
+
+	b, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	objType := int(b>>4) & 7
+	for b&0x80 != 0 {
+		if b, err = reader.ReadByte(); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	var baseType int
+	var base []byte
+
+	switch objType {
+	case objCommit, objTree, objBlob, objTag:
+		data, err := inflate(reader)
+		return objType, data, err
+	case objOfsDelta:
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		distance := int64(b & 0x7f)
+		for b&0x80 != 0 {
+			if b, err = reader.ReadByte(); err != nil {
+				return 0, nil, err
+			}
+			distance = ((distance + 1) << 7) | int64(b&0x7f)
+		}
+		baseType, base, err = s.readPacked(p, offset-distance, depth+1)
+		if err != nil {
+			return 0, nil, err
+		}
+	case objRefDelta:
+		baseOid := make([]byte, 20)
+		if _, err := io.ReadFull(reader, baseOid); err != nil {
+			return 0, nil, err
+		}
+		baseType, base, err = s.readDepth(hex.EncodeToString(baseOid), depth+1)
+		if err != nil {
+			return 0, nil, err
+		}
+	default:
+		return 0, nil, fmt.Errorf("unknown pack object type %d", objType)
+	}
+
+	delta, err := inflate(reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	data, err := applyDelta(base, delta)
+	return baseType, data, err
+}
+
+// inflate decompresses a zlib stream.
+func inflate(r io.Reader) ([]byte, error) {
+	reader, err := zlib.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// applyDelta reconstructs an object from its base and a git delta.
+func applyDelta(base, delta []byte) ([]byte, error) {
+	errCorrupt := fmt.Errorf("corrupt delta")
+
+	readSize := func() (int, bool) {
+		size, shift := 0, 0
+		for len(delta) > 0 {
+			b := delta[0]
+			delta = delta[1:]
+			size |= int(b&0x7f) << shift
+			shift += 7
+			if b&0x80 == 0 {
+				return size, true
+			}
+		}
+		return 0, false
+	}
+
+	baseSize, ok := readSize()
+	if !ok || baseSize != len(base) {
+		return nil, errCorrupt
+	}
+	resultSize, ok := readSize()
+	if !ok {
+		return nil, errCorrupt
+	}
+
+	result := make([]byte, 0, resultSize)
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+
+		switch {
+		case op&0x80 != 0:
+			// Copy a range of the base object
+			var offset, size int
+			for bit := 0; bit < 4; bit++ {
+				if op&(1<<bit) != 0 {
+					if len(delta) == 0 {
+						return nil, errCorrupt
+					}
+					offset |= int(delta[0]) << (8 * bit)
+					delta = delta[1:]
+				}
+			}
+			for bit := 0; bit < 3; bit++ {
+				if op&(0x10<<bit) != 0 {
+					if len(delta) == 0 {
+						return nil, errCorrupt
+					}
+					size |= int(delta[0]) << (8 * bit)
+					delta = delta[1:]
+				}
+			}
+			if size == 0 {
+				size = 0x10000
+			}
+			if offset+size > len(base) {
+				return nil, errCorrupt
+			}
+			result = append(result, base[offset:offset+size]...)
+		case op != 0:
+			// Insert literal data from the delta
+			size := int(op)
+			if size > len(delta) {
+				return nil, errCorrupt
+			}
+			result = append(result, delta[:size]...)
+			delta = delta[size:]
+		default:
+			return nil, errCorrupt
+		}
+	}
+
+	if len(result) != resultSize {
+		return nil, errCorrupt
+	}
+	return result, nil
+}
+
+// commit holds the parts of a commit object needed for status reads.
+type commit struct {
+	Tree    string
+	Parents []string
+	Time    int64 // Committer timestamp in seconds
+}
+
+// readCommit reads and parses a commit object.
+func (r *Repository) readCommit(oid string) (commit, error) {
+	objType, data, err := r.objects.read(oid)
+	if err != nil {
+		return commit{}, err
+	}
+	if objType != objCommit {
+		return commit{}, fmt.Errorf("object %s is not a commit", oid)
+	}
+	return parseCommit(data), nil
+}
+
+// parseCommit parses the header of a commit object.
+func parseCommit(data []byte) commit {
+	var c commit
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			// The header ends at the first empty line
+			break
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		switch key {
+		case "tree":
+			c.Tree = value
+		case "parent":
+			c.Parents = append(c.Parents, value)
+		case "committer":
+			// "Name <email> <timestamp> <timezone>"
+			fields := strings.Fields(value)
+			if len(fields) >= 2 {
+				c.Time, _ = strconv.ParseInt(fields[len(fields)-2], 10, 64)
+			}
+		}
+	}
+	return c
+}
@@ -0,0 +1,155 @@
+package gitnative
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// maxSymrefDepth bounds how many symbolic refs are followed, like git does.
+const maxSymrefDepth = 5
+
+// Head describes what HEAD of a worktree points at.
+type Head struct {
+	Oid string // Commit HEAD resolves to, empty on an unborn branch
+	Ref string // Full name of the checked out branch, empty when detached
+}
+
+// Detached reports whether HEAD points directly at a commit.
+func (h Head) Detached() bool {
+	return h.Ref == ""
+}
+
+// Branch returns the short name of the checked out branch.
+func (h Head) Branch() string {
+	return strings.TrimPrefix(h.Ref, "refs/heads/")
+}
+
+// Head reads HEAD of the repository's worktree.
+func (r *Repository) Head() (Head, error) {
+	return r.readHead(r.GitDir)
+}
+
+// readHead reads the HEAD file in gitDir and resolves the branch it points at.
+func (r *Repository) readHead(gitDir string) (Head, error) {
+	content, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return Head{}, err
+	}
+
+	value := strings.TrimSpace(string(content))
+	target, symbolic := strings.CutPrefix(value, "ref: ")
+	if !symbolic {
+		if !isHexOid(value) {
+			return Head{}, ErrUnsupported
+		}
+		return Head{Oid: value}, nil
+	}
+
+	oid, _, err := r.resolveRef(gitDir, target)
+	if err != nil {
+		return Head{}, err
+	}
+	return Head{Oid: oid, Ref: target}, nil
+}
+
+// ResolveRef resolves a full ref name to a commit id.
+// The boolean result is false when the ref does not exist.
+func (r *Repository) ResolveRef(name string) (string, bool, error) {
+	return r.resolveRef(r.GitDir, name)
+}
+
+// resolveRef resolves name, following symbolic refs, as seen from the worktree with gitDir.
+func (r *Repository) resolveRef(gitDir, name string) (string, bool, error) {
+	for depth := 0; depth < maxSymrefDepth; depth++ {
+		value, found, err := r.readRef(gitDir, name)
+		if err != nil || !found {
+			return "", false, err
+		}
+
+		target, symbolic := strings.CutPrefix(value, "ref: ")
+		if !symbolic {
+			if !isHexOid(value) {
+				return "", false, ErrUnsupported
+			}
+			return value, true, nil
+		}
+		name = target
+	}
+	return "", false, ErrUnsupported
+}
+
+// readRef returns the raw value of a loose or packed ref.
+func (r *Repository) readRef(gitDir, name string) (string, bool, error) {
+	dir := r.CommonDir
+	if isPerWorktreeRef(name) {
+		dir = gitDir
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	if err == nil {
+		return strings.TrimSpace(string(content)), true, nil
+	}
+	if !os.IsNotExist(err) && !isNotDir(err) {
+		return "", false, err
+	}
+
+	return r.readPackedRef(name)
+}
+
+// readPackedRef looks a ref up in the packed-refs file of the common directory.
+func (r *Repository) readPackedRef(name string) (string, bool, error) {
+	file, err := os.Open(filepath.Join(r.CommonDir, "packed-refs"))
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == '#' || line[0] == '^' {
+			continue
+		}
+		oid, ref, found := strings.Cut(line, " ")
+		if found && ref == name {
+			return oid, true, nil
+		}
+	}
+	return "", false, scanner.Err()
+}
+
+// isPerWorktreeRef reports whether a ref is stored in the worktree's own git directory.
+func isPerWorktreeRef(name string) bool {
+	if !strings.HasPrefix(name, "refs/") {
+		return true
+	}
+	return strings.HasPrefix(name, "refs/bisect/") ||
+		strings.HasPrefix(name, "refs/worktree/") ||
+		strings.HasPrefix(name, "refs/rewritten/")
+}
+
+// isNotDir reports whether err was caused by a path component being a file.
+func isNotDir(err error) bool {
+	return errors.Is(err, syscall.ENOTDIR)
+}
+
+// isHexOid reports whether s is a full hexadecimal SHA-1 object id.
+func isHexOid(s string) bool {
+	if len(s) != 40 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+// Package gitnative reads repository state directly from the .git directory
+// without spawning git processes.
+//
+// Only the common on-disk formats are understood. Whenever a repository uses
+// something this package does not implement (SHA-256 objects, reftable, split
+// or sparse indexes, conflicts, clean filters, ...) the reading functions
+// return ErrUnsupported so callers can fall back to the git executable.
+package gitnative
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupported is returned when the repository uses a feature this package cannot read.
+var ErrUnsupported = errors.New("gitnative: unsupported repository state")
+
+// Repository is an opened repository or linked worktree.
+type Repository struct {
+	WorkDir   string // Root of the working tree, empty for bare repositories
+	GitDir    string // Git directory of this worktree
+	CommonDir string // Git directory shared by all worktrees
+	Bare      bool   // Whether the repository has no working tree
+
+	config  *gitConfig
+	objects *objectStore
+}
+
+// Open opens the repository whose working tree root or bare git directory is path.
+// Subdirectories of a working tree are not resolved and yield ErrUnsupported.
+func Open(path string) (*Repository, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &Repository{}
+
+	dotGit := filepath.Join(path, ".git")
+	info, err := os.Stat(dotGit)
+	switch {
+	case err == nil && info.IsDir():
+		repo.WorkDir = path
+		repo.GitDir = dotGit
+	case err == nil:
+		gitDir, err := readGitFile(dotGit)
+		if err != nil {
+			return nil, err
+		}
+		repo.WorkDir = path
+		repo.GitDir = gitDir
+	case isGitDir(path):
+		repo.GitDir = path
+		repo.Bare = true
+	default:
+		return nil, ErrUnsupported
+	}
+
+	repo.CommonDir = repo.GitDir
+	if content, err := os.ReadFile(filepath.Join(repo.GitDir, "commondir")); err == nil {
+		repo.CommonDir = resolvePath(repo.GitDir, strings.TrimSpace(string(content)))
+	}
+
+	repo.config, err = loadConfig(repo.CommonDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.checkSupported(); err != nil {
+		return nil, err
+	}
+
+	repo.objects = newObjectStore(filepath.Join(repo.CommonDir, "objects"))
+	return repo, nil
+}
+
+// checkSupported rejects repository formats this package cannot read.
+func (r *Repository) checkSupported() error {
+	if format, ok := r.config.get("extensions.objectformat"); ok && format != "sha1" {
+		return ErrUnsupported
+	}
+	if storage, ok := r.config.get("extensions.refstorage"); ok && storage != "files" {
+		return ErrUnsupported
+	}
+	if r.config.getBool("extensions.worktreeconfig", false) {
+		return ErrUnsupported
+	}
+	// A git directory opened directly is only a bare repository when configured as one
+	if r.Bare && !r.config.getBool("core.bare", false) {
+		return ErrUnsupported
+	}
+	return nil
+}
+
+// readGitFile resolves a ".git" file of the form "gitdir: <path>".
+func readGitFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	gitDir, found := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir: ")
+	if !found {
+		return "", ErrUnsupported
+	}
+	return resolvePath(filepath.Dir(path), gitDir), nil
+}
+
+// isGitDir reports whether path looks like a git directory.
+func isGitDir(path string) bool {
+	if _, err := os.Stat(filepath.Join(path, "HEAD")); err != nil {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(path, "objects"))
+	return err == nil && info.IsDir()
+}
+
+// resolvePath makes target absolute relative to base.
+func resolvePath(base, target string) string {
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(base, target)
+	}
+	return filepath.Clean(target)
+}
+
+// Close releases the pack files opened while reading objects.
+func (r *Repository) Close() {
+	r.objects.close()
+}
@@ -0,0 +1,478 @@
+package gitnative
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// File modes as stored in the index.
+const (
+	modeRegular    = 0o100644
+	modeExecutable = 0o100755
+	modeSymlink    = 0o120000
+	modeGitlink    = 0o160000
+)
+
+// Status is the state of a worktree relative to its index and HEAD.
+type Status struct {
+	Head     Head
+	Upstream string // Short upstream name (e.g. "origin/main"), empty when none is configured
+	HasAB    bool   // Whether the upstream ref exists, so Ahead and Behind are known
+	Ahead    int
+	Behind   int
+
+	Changes   []Change // Tracked files that differ from the index, in path order
+	Untracked []string // Untracked paths; wholly untracked directories end with "/"
+}
+
+// Change is a tracked file whose worktree content differs from the index.
+// Status only supports worktrees without staged changes, so the index
+// always matches HEAD for every change.
+type Change struct {
+	Path         string
+	Kind         byte   // 'M' modified, 'D' deleted, 'T' type changed
+	Mode         uint32 // Mode in HEAD and the index
+	WorktreeMode uint32 // Mode in the worktree, 0 when deleted
+	Oid          string // Blob id in HEAD and the index
+}
+
+// Status computes the worktree status. Worktrees with staged changes, conflicts,
+// submodules or content filters return ErrUnsupported.
+func (r *Repository) Status() (*Status, error) {
+	if r.Bare {
+		return nil, ErrUnsupported
+	}
+	if err := r.checkStatusConfig(); err != nil {
+		return nil, err
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := r.readIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.checkNothingStaged(head, idx); err != nil {
+		return nil, err
+	}
+
+	status := &Status{Head: head}
+
+	if status.Changes, err = r.worktreeChanges(idx); err != nil {
+		return nil, err
+	}
+	if status.Untracked, err = r.untrackedFiles(idx); err != nil {
+		return nil, err
+	}
+	if err := r.readUpstream(status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// checkStatusConfig rejects settings that change which files git reports.
+func (r *Repository) checkStatusConfig() error {
+	if mode, ok := r.config.get("status.showuntrackedfiles"); ok && mode != "normal" {
+		return ErrUnsupported
+	}
+	if r.config.getBool("core.ignorecase", false) || !r.config.getBool("core.symlinks", true) {
+		return ErrUnsupported
+	}
+	if _, ok := r.config.get("core.sparsecheckout"); ok && r.config.getBool("core.sparsecheckout", false) {
+		return ErrUnsupported
+	}
+	return nil
+}
+
+// checkNothingStaged verifies that the index matches HEAD using the cache-tree extension.
+// Comparing every entry against the HEAD tree is not implemented, so anything else is unsupported.
+func (r *Repository) checkNothingStaged(head Head, idx *index) error {
+	if head.Oid == "" {
+		// On an unborn branch everything in the index is staged
+		if len(idx.Entries) > 0 {
+			return ErrUnsupported
+		}
+		return nil
+	}
+
+	c, err := r.readCommit(head.Oid)
+	if err != nil {
+		return err
+	}
+	if idx.Tree == "" || idx.Tree != c.Tree {
+		return ErrUnsupported
+	}
+	return nil
+}
+
+// worktreeChanges compares every index entry with the file in the worktree.
+func (r *Repository) worktreeChanges(idx *index) ([]Change, error) {
+	fileMode := r.config.getBool("core.filemode", true)
+	filtered := r.usesContentFilters(idx)
+
+	var changes []Change
+	for _, entry := range idx.Entries {
+		if entry.Mode == modeGitlink {
+			return nil, ErrUnsupported
+		}
+
+		info, err := os.Lstat(filepath.Join(r.WorkDir, filepath.FromSlash(entry.Path)))
+		if os.IsNotExist(err) || isNotDir(err) {
+			changes = append(changes, Change{Path: entry.Path, Kind: 'D', Mode: entry.Mode, Oid: entry.Oid})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		worktreeMode, ok := worktreeModeOf(info, entry.Mode, fileMode)
+		if !ok {
+			// A directory replaced a tracked file
+			return nil, ErrUnsupported
+		}
+
+		change := Change{Path: entry.Path, Mode: entry.Mode, WorktreeMode: worktreeMode, Oid: entry.Oid}
+		switch {
+		case worktreeMode&0o170000 != entry.Mode&0o170000:
+			change.Kind = 'T'
+		case worktreeMode != entry.Mode:
+			change.Kind = 'M'
+		default:
+			if r.statMatches(entry, info, idx) {
+				continue
+			}
+			if filtered {
+				return nil, ErrUnsupported
+			}
+
+			oid, err := hashWorktreeFile(filepath.Join(r.WorkDir, filepath.FromSlash(entry.Path)), info)
+			if err != nil {
+				return nil, err
+			}
+			if oid == entry.Oid {
+				continue
+			}
+			change.Kind = 'M'
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// worktreeModeOf returns the index mode git would record for a worktree file.
+func worktreeModeOf(info fs.FileInfo, indexMode uint32, fileMode bool) (uint32, bool) {
+	switch {
+	case info.Mode()&fs.ModeSymlink != 0:
+		return modeSymlink, true
+	case info.Mode().IsRegular():
+		if !fileMode && indexMode&0o170000 == modeRegular&0o170000 {
+			// Without core.fileMode the executable bit is taken from the index
+			return indexMode, true
+		}
+		if info.Mode()&0o100 != 0 {
+			return modeExecutable, true
+		}
+		return modeRegular, true
+	default:
+		return 0, false
+	}
+}
+
+// statMatches reports whether the cached stat data proves the file is unchanged.
+// Entries written in the same second as the index are racy and must be hashed.
+func (r *Repository) statMatches(entry indexEntry, info fs.FileInfo, idx *index) bool {
+	mtime := info.ModTime()
+	if uint32(info.Size()) != entry.Size || uint32(mtime.Unix()) != entry.MtimeSec {
+		return false
+	}
+	if entry.MtimeNsec != 0 && uint32(mtime.Nanosecond()) != entry.MtimeNsec {
+		return false
+	}
+
+	racy := int64(entry.MtimeSec) > idx.MtimeSec ||
+		(int64(entry.MtimeSec) == idx.MtimeSec && int64(entry.MtimeNsec) >= idx.MtimeNs)
+	return !racy
+}
+
+// usesContentFilters reports whether worktree content may be converted before hashing,
+// in which case hashing the raw file would not reproduce the blob id.
+func (r *Repository) usesContentFilters(idx *index) bool {
+	if autocrlf, ok := r.config.get("core.autocrlf"); ok && autocrlf != "false" {
+		return true
+	}
+	if _, ok := r.config.get("core.attributesfile"); ok {
+		return true
+	}
+	if _, err := os.Stat(filepath.Join(r.CommonDir, "info", "attributes")); err == nil {
+		return true
+	}
+
+	for _, entry := range idx.Entries {
+		if entry.Path == ".gitattributes" || strings.HasSuffix(entry.Path, "/.gitattributes") {
+			return true
+		}
+	}
+	return false
+}
+
+// hashWorktreeFile computes the blob id of a worktree file or symlink.
+func hashWorktreeFile(path string, info fs.FileInfo) (string, error) {
+	var content []byte
+	if info.Mode()&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(path)
+		if err != nil {
+			return "", err
+		}
+		content = []byte(target)
+	} else {
+		var err error
+		if content, err = os.ReadFile(path); err != nil {
+			return "", err
+		}
+	}
+
+	hash := sha1.New()
+	fmt.Fprintf(hash, "blob %d\x00", len(content))
+	hash.Write(content)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// untrackedFiles lists files that are neither tracked nor ignored, collapsing
+// directories without tracked files into a single entry like git does.
+func (r *Repository) untrackedFiles(idx *index) ([]string, error) {
+	tracked := make(map[string]bool, len(idx.Entries))
+	trackedDirs := make(map[string]bool)
+	for _, entry := range idx.Entries {
+		tracked[entry.Path] = true
+		for dir := entry.Path; strings.Contains(dir, "/"); {
+			dir = dir[:strings.LastIndexByte(dir, '/')]
+			if trackedDirs[dir] {
+				break
+			}
+			trackedDirs[dir] = true
+		}
+	}
+
+	ignores, err := r.baseIgnores()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &untrackedWalker{root: r.WorkDir, tracked: tracked, trackedDirs: trackedDirs}
+	if err := w.walk("", ignores); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(w.untracked)
+	return w.untracked, nil
+}
+
+// baseIgnores loads the exclude files that apply to the whole worktree.
+func (r *Repository) baseIgnores() (*ignoreList, error) {
+	ignores := &ignoreList{}
+
+	excludesFile, ok := r.config.get("core.excludesfile")
+	if ok {
+		if strings.HasPrefix(excludesFile, "~/") {
+			home, _ := os.UserHomeDir()
+			excludesFile = filepath.Join(home, excludesFile[2:])
+		}
+	} else if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		excludesFile = filepath.Join(xdg, "git", "ignore")
+	} else if home, err := os.UserHomeDir(); err == nil {
+		excludesFile = filepath.Join(home, ".config", "git", "ignore")
+	}
+
+	if excludesFile != "" {
+		if err := ignores.addFile(excludesFile, ""); err != nil {
+			return nil, err
+		}
+	}
+	if err := ignores.addFile(filepath.Join(r.CommonDir, "info", "exclude"), ""); err != nil {
+		return nil, err
+	}
+	return ignores, nil
+}
+
+// untrackedWalker collects untracked paths while descending the worktree.
+type untrackedWalker struct {
+	root        string
+	tracked     map[string]bool
+	trackedDirs map[string]bool
+	untracked   []string
+}
+
+// walk lists the untracked entries of a directory containing tracked files.
+func (w *untrackedWalker) walk(rel string, ignores *ignoreList) error {
+	dir := filepath.Join(w.root, filepath.FromSlash(rel))
+	ignores, err := ignores.with(filepath.Join(dir, ".gitignore"), rel)
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Name() == ".git" {
+			continue
+		}
+
+		path := joinRel(rel, entry.Name())
+		isDir := entry.IsDir()
+
+		if w.tracked[path] {
+			continue
+		}
+		if ignores.ignored(path, isDir) {
+			continue
+		}
+		if !isDir {
+			w.untracked = append(w.untracked, path)
+			continue
+		}
+
+		if w.trackedDirs[path] {
+			if err := w.walk(path, ignores); err != nil {
+				return err
+			}
+			continue
+		}
+
+		has, err := w.hasUntracked(path, ignores)
+		if err != nil {
+			return err
+		}
+		if has {
+			w.untracked = append(w.untracked, path+"/")
+		}
+	}
+	return nil
+}
+
+// hasUntracked reports whether an untracked directory contains anything git would show.
+// Nested repositories always count, empty or fully ignored directories do not.
+func (w *untrackedWalker) hasUntracked(rel string, ignores *ignoreList) (bool, error) {
+	dir := filepath.Join(w.root, filepath.FromSlash(rel))
+	if _, err := os.Lstat(filepath.Join(dir, ".git")); err == nil {
+		return true, nil
+	}
+
+	ignores, err := ignores.with(filepath.Join(dir, ".gitignore"), rel)
+	if err != nil {
+		return false, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		path := joinRel(rel, entry.Name())
+		if ignores.ignored(path, entry.IsDir()) {
+			continue
+		}
+		if !entry.IsDir() {
+			return true, nil
+		}
+		has, err := w.hasUntracked(path, ignores)
+		if err != nil || has {
+			return has, err
+		}
+	}
+	return false, nil
+}
+
+// joinRel joins worktree-relative path components with forward slashes.
+func joinRel(dir, name string) string {
+	if dir == "" {
+		return name
+	}
+	return dir + "/" + name
+}
+
+// readUpstream fills in the upstream of the checked out branch and the ahead/behind counts.
+func (r *Repository) readUpstream(status *Status) error {
+	if status.Head.Detached() {
+		return nil
+	}
+
+	branch := status.Head.Branch()
+	remote, hasRemote := r.config.get("branch." + branch + ".remote")
+	merge, hasMerge := r.config.get("branch." + branch + ".merge")
+	if !hasRemote || !hasMerge {
+		return nil
+	}
+
+	trackingRef, err := r.trackingRef(remote, merge)
+	if err != nil {
+		return err
+	}
+	status.Upstream = shortRefName(trackingRef)
+
+	upstreamOid, found, err := r.ResolveRef(trackingRef)
+	if err != nil || !found {
+		return err
+	}
+	if status.Head.Oid == "" {
+		return ErrUnsupported
+	}
+
+	status.HasAB = true
+	if status.Head.Oid == upstreamOid {
+		return nil
+	}
+
+	status.Ahead, status.Behind, err = r.aheadBehind(status.Head.Oid, upstreamOid)
+	return err
+}
+
+// trackingRef maps the merge ref of a branch to the local ref tracking it,
+// using the fetch refspecs of the remote.
+func (r *Repository) trackingRef(remote, merge string) (string, error) {
+	if remote == "." {
+		return merge, nil
+	}
+
+	refspec, ok := r.config.get("remote." + remote + ".fetch")
+	if !ok {
+		return "", ErrUnsupported
+	}
+
+	refspec = strings.TrimPrefix(refspec, "+")
+	src, dst, found := strings.Cut(refspec, ":")
+	if !found || strings.Count(src, "*") != 1 || strings.Count(dst, "*") != 1 {
+		return "", ErrUnsupported
+	}
+
+	srcPrefix, srcSuffix, _ := strings.Cut(src, "*")
+	if !strings.HasPrefix(merge, srcPrefix) || !strings.HasSuffix(merge, srcSuffix) {
+		return "", ErrUnsupported
+	}
+	matched := merge[len(srcPrefix) : len(merge)-len(srcSuffix)]
+	return strings.Replace(dst, "*", matched, 1), nil
+}
+
+// shortRefName shortens a full ref name the way git prints upstream branches.
+func shortRefName(ref string) string {
+	for _, prefix := range []string{"refs/heads/", "refs/remotes/", "refs/tags/", "refs/"} {
+		if short, found := strings.CutPrefix(ref, prefix); found {
+			return short
+		}
+	}
+	return ref
+}
@@ -0,0 +1,93 @@
+package gitnative
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Worktree is an entry of the repository's worktree list.
+type Worktree struct {
+	Path           string
+	Head           Head
+	Bare           bool
+	Locked         bool
+	LockReason     string
+	Prunable       bool
+	PrunableReason string
+}
+
+// Worktrees lists the main worktree followed by all linked worktrees.
+func (r *Repository) Worktrees() ([]Worktree, error) {
+	mainPath, err := filepath.EvalSymlinks(r.CommonDir)
+	if err != nil {
+		return nil, err
+	}
+
+	main := Worktree{Path: mainPath}
+	if r.config.getBool("core.bare", false) {
+		main.Bare = true
+	} else {
+		main.Path = strings.TrimSuffix(mainPath, string(filepath.Separator)+".git")
+		if main.Head, err = r.readHead(r.CommonDir); err != nil {
+			return nil, err
+		}
+	}
+
+	worktrees := []Worktree{main}
+
+	entries, err := os.ReadDir(filepath.Join(r.CommonDir, "worktrees"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var linked []Worktree
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		wt, ok, err := r.readLinkedWorktree(filepath.Join(r.CommonDir, "worktrees", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			linked = append(linked, wt)
+		}
+	}
+
+	sort.Slice(linked, func(i, j int) bool { return linked[i].Path < linked[j].Path })
+	return append(worktrees, linked...), nil
+}
+
+// readLinkedWorktree reads the administrative directory of a linked worktree.
+func (r *Repository) readLinkedWorktree(adminDir string) (Worktree, bool, error) {
+	content, err := os.ReadFile(filepath.Join(adminDir, "gitdir"))
+	if os.IsNotExist(err) {
+		return Worktree{}, false, ErrUnsupported
+	}
+	if err != nil {
+		return Worktree{}, false, err
+	}
+
+	dotGit := resolvePath(adminDir, strings.TrimSpace(string(content)))
+	wt := Worktree{Path: filepath.Dir(dotGit)}
+
+	if wt.Head, err = r.readHead(adminDir); err != nil {
+		return Worktree{}, false, err
+	}
+
+	if reason, err := os.ReadFile(filepath.Join(adminDir, "locked")); err == nil {
+		wt.Locked = true
+		wt.LockReason = strings.TrimSpace(string(reason))
+	}
+
+	if !wt.Locked {
+		if _, err := os.Stat(dotGit); os.IsNotExist(err) {
+			wt.Prunable = true
+			wt.PrunableReason = "gitdir file points to non-existent location"
+		}
+	}
+
+	return wt, true, nil
+}
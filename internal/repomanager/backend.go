@@ -0,0 +1,155 @@
+package repomanager
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// GitBackend runs git commands on behalf of the repository manager.
+// Implementations return the command's standard output, and an error
+// describing the failure when the command does not succeed.
+type GitBackend interface {
+	Run(ctx context.Context, dir string, args ...string) ([]byte, error)
+}
+
+// NewBackend returns the backend selected by the git_backend configuration key.
+func NewBackend(name string) GitBackend {
+	if name == config.GitBackendNative {
+		return NewNativeBackend(NewExecBackend())
+	}
+	return NewExecBackend()
+}
+
+// ExecBackend runs git commands by spawning the git executable.
+type ExecBackend struct{}
+
+// NewExecBackend creates a backend that uses the git executable found in PATH.
+func NewExecBackend() *ExecBackend {
+	return &ExecBackend{}
+}
+
+// Run executes git with the given arguments in dir.
+// Credential prompts are disabled so background commands can never block on input,
+// and optional locks are skipped so status reads do not rewrite the index.
+func (b *ExecBackend) Run(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_OPTIONAL_LOCKS=0")
+
+	output, err := cmd.Output()
+	return output, gitError(err)
+}
+
+// gitError replaces a bare exit status error with the message git printed on stderr.
+func gitError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if message := strings.TrimSpace(string(exitErr.Stderr)); message != "" {
+			return errors.New(message)
+		}
+	}
+	return err
+}
+
+// OutputLine is a line printed by a long-running git command such as push or pull.
+type OutputLine struct {
+	Text     string
+	Progress bool // Ended with a carriage return, so the next line replaces it
+}
+
+// StreamingBackend is implemented by backends that report output while a command
+// is still running instead of returning it once the command finished.
+type StreamingBackend interface {
+	Stream(ctx context.Context, dir string, output func(OutputLine), args ...string) error
+}
+
+// streamCommand runs a git command through backend and reports its output line by
+// line, or all at once when the backend cannot stream.
+func streamCommand(ctx context.Context, backend GitBackend, dir string, output func(OutputLine), args ...string) error {
+	if streaming, ok := backend.(StreamingBackend); ok {
+		return streaming.Stream(ctx, dir, output, args...)
+	}
+
+	result, err := backend.Run(ctx, dir, args...)
+	for _, line := range strings.Split(string(result), "\n") {
+		if line != "" {
+			output(OutputLine{Text: line})
+		}
+	}
+	return err
+}
+
+// streamWaitDelay bounds how long a cancelled streaming command waits for its output
+// to close. Children such as ssh or a credential helper can outlive the killed git
+// and keep the pipes open.
+const streamWaitDelay = 2 * time.Second
+
+// Stream executes git with the given arguments in dir and reports standard output
+// and standard error line by line. A failure is described by the last line git printed.
+func (b *ExecBackend) Stream(ctx context.Context, dir string, output func(OutputLine), args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.WaitDelay = streamWaitDelay
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	// Sharing one writer makes exec serialize the writes of both streams
+	writer := &lineWriter{output: output}
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	err := cmd.Run()
+	writer.flush()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil && writer.last != "" {
+		return errors.New(writer.last)
+	}
+	return err
+}
+
+// lineWriter splits written output into lines at newlines and carriage returns.
+type lineWriter struct {
+	output  func(OutputLine)
+	partial []byte
+	last    string // Last complete line, used to describe failures
+}
+
+// Write reports every line completed by p and keeps the rest for the next write.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	for _, c := range p {
+		switch c {
+		case '\n':
+			w.emit(false)
+		case '\r':
+			w.emit(true)
+		default:
+			w.partial = append(w.partial, c)
+		}
+	}
+	return len(p), nil
+}
+
+// flush reports output that was not terminated by a newline.
+func (w *lineWriter) flush() {
+	w.emit(false)
+}
+
+// emit reports the pending line, skipping the empty line of a "\r\n" sequence.
+func (w *lineWriter) emit(progress bool) {
+	if len(w.partial) == 0 {
+		return
+	}
+
+	text := string(w.partial)
+	w.partial = w.partial[:0]
+	if !progress {
+		w.last = text
+	}
+	w.output(OutputLine{Text: text, Progress: progress})
+}
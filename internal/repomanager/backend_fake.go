@@ -0,0 +1,81 @@
+package repomanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FakeBackend is an in-memory GitBackend that returns canned responses.
+// It is intended for tests that exercise the manager without real repositories.
+type FakeBackend struct {
+	mu        sync.Mutex
+	responses map[string]fakeResponse
+	calls     []FakeCall
+}
+
+// FakeCall records a single command received by a FakeBackend.
+type FakeCall struct {
+	Dir  string
+	Args []string
+}
+
+// fakeResponse is the canned result of a command.
+type fakeResponse struct {
+	output string
+	err    error
+}
+
+// NewFakeBackend creates an empty fake backend.
+func NewFakeBackend() *FakeBackend {
+	return &FakeBackend{responses: make(map[string]fakeResponse)}
+}
+
+// SetOutput makes the command with the given arguments in dir succeed with output.
+func (b *FakeBackend) SetOutput(dir, output string, args ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.responses[fakeKey(dir, args)] = fakeResponse{output: output}
+}
+
+// SetError makes the command with the given arguments in dir fail with err.
+func (b *FakeBackend) SetError(dir string, err error, args ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.responses[fakeKey(dir, args)] = fakeResponse{err: err}
+}
+
+// Calls returns all commands received so far, in order.
+func (b *FakeBackend) Calls() []FakeCall {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	calls := make([]FakeCall, len(b.calls))
+	copy(calls, b.calls)
+	return calls
+}
+
+// Run returns the canned response for the command.
+// Commands without a response fail like git would outside a repository.
+func (b *FakeBackend) Run(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.calls = append(b.calls, FakeCall{Dir: dir, Args: args})
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	response, ok := b.responses[fakeKey(dir, args)]
+	if !ok {
+		return nil, fmt.Errorf("fatal: not a git repository: %s", dir)
+	}
+	return []byte(response.output), response.err
+}
+
+// fakeKey identifies a command by directory and arguments.
+func fakeKey(dir string, args []string) string {
+	return dir + "\x00" + strings.Join(args, "\x00")
+}
@@ -0,0 +1,196 @@
+package repomanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jarmocluyse/git-dash/internal/gitnative"
+)
+
+// NativeBackend answers the read-only commands used for status refreshes in-process
+// and hands every other command, or any repository it cannot read, to a fallback backend.
+type NativeBackend struct {
+	fallback GitBackend
+}
+
+// NewNativeBackend creates a native backend that uses fallback for unsupported commands.
+func NewNativeBackend(fallback GitBackend) *NativeBackend {
+	return &NativeBackend{fallback: fallback}
+}
+
+// Run executes a git command, in-process when possible.
+func (b *NativeBackend) Run(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	output, handled := b.runNative(dir, args)
+	if handled {
+		return output, nil
+	}
+	return b.fallback.Run(ctx, dir, args...)
+}
+
+// runNative produces the output of supported commands. It reports false when the
+// command or the repository state is unsupported, or when reading failed, so that
+// git itself can produce the authoritative output or error.
+func (b *NativeBackend) runNative(dir string, args []string) ([]byte, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+
+	var format func(repo *gitnative.Repository) (string, error)
+	switch strings.Join(args, " ") {
+	case "status --porcelain=v2 --branch":
+		format = formatNativeStatus
+	case "worktree list --porcelain":
+		format = formatNativeWorktrees
+	default:
+		if args[0] != "rev-parse" {
+			return nil, false
+		}
+		format = func(repo *gitnative.Repository) (string, error) {
+			return formatNativeRevParse(repo, args[1:])
+		}
+	}
+
+	repo, err := gitnative.Open(dir)
+	if err != nil {
+		return nil, false
+	}
+	defer repo.Close()
+
+	output, err := format(repo)
+	if err != nil {
+		return nil, false
+	}
+	return []byte(output), true
+}
+
+// formatNativeRevParse answers the repository layout queries of rev-parse.
+func formatNativeRevParse(repo *gitnative.Repository, flags []string) (string, error) {
+	var out strings.Builder
+	for _, flag := range flags {
+		switch flag {
+		case "--is-bare-repository":
+			fmt.Fprintln(&out, repo.Bare)
+		case "--is-inside-work-tree":
+			fmt.Fprintln(&out, !repo.Bare)
+		case "--git-dir":
+			fmt.Fprintln(&out, repo.GitDir)
+		case "--git-common-dir":
+			fmt.Fprintln(&out, repo.CommonDir)
+		default:
+			return "", gitnative.ErrUnsupported
+		}
+	}
+	return out.String(), nil
+}
+
+// formatNativeStatus renders a status in the format of git status --porcelain=v2 --branch.
+func formatNativeStatus(repo *gitnative.Repository) (string, error) {
+	status, err := repo.Status()
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+
+	head := status.Head.Oid
+	if head == "" {
+		head = "(initial)"
+	}
+	fmt.Fprintf(&out, "# branch.oid %s\n", head)
+
+	if status.Head.Detached() {
+		out.WriteString("# branch.head (detached)\n")
+	} else {
+		fmt.Fprintf(&out, "# branch.head %s\n", status.Head.Branch())
+	}
+
+	if status.Upstream != "" {
+		fmt.Fprintf(&out, "# branch.upstream %s\n", status.Upstream)
+		if status.HasAB {
+			fmt.Fprintf(&out, "# branch.ab +%d -%d\n", status.Ahead, status.Behind)
+		}
+	}
+
+	for _, change := range status.Changes {
+		if needsQuoting(change.Path) {
+			return "", gitnative.ErrUnsupported
+		}
+		fmt.Fprintf(&out, "1 .%c N... %06o %06o %06o %s %s %s\n",
+			change.Kind, change.Mode, change.Mode, change.WorktreeMode, change.Oid, change.Oid, change.Path)
+	}
+
+	for _, path := range status.Untracked {
+		if needsQuoting(path) {
+			return "", gitnative.ErrUnsupported
+		}
+		fmt.Fprintf(&out, "? %s\n", path)
+	}
+
+	return out.String(), nil
+}
+
+// formatNativeWorktrees renders worktrees in the format of git worktree list --porcelain.
+func formatNativeWorktrees(repo *gitnative.Repository) (string, error) {
+	worktrees, err := repo.Worktrees()
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	for _, wt := range worktrees {
+		fmt.Fprintf(&out, "worktree %s\n", wt.Path)
+
+		if wt.Bare {
+			out.WriteString("bare\n")
+		} else {
+			head := wt.Head.Oid
+			if head == "" {
+				head = strings.Repeat("0", 40)
+			}
+			fmt.Fprintf(&out, "HEAD %s\n", head)
+			if wt.Head.Detached() {
+				out.WriteString("detached\n")
+			} else {
+				fmt.Fprintf(&out, "branch %s\n", wt.Head.Ref)
+			}
+		}
+
+		if wt.Locked {
+			if wt.LockReason != "" {
+				if strings.ContainsAny(wt.LockReason, "\n\"\\") {
+					return "", gitnative.ErrUnsupported
+				}
+				fmt.Fprintf(&out, "locked %s\n", wt.LockReason)
+			} else {
+				out.WriteString("locked\n")
+			}
+		}
+		if wt.Prunable {
+			fmt.Fprintf(&out, "prunable %s\n", wt.PrunableReason)
+		}
+		out.WriteString("\n")
+	}
+	return out.String(), nil
+}
+
+// needsQuoting reports whether git would C-quote a path in porcelain output.
+func needsQuoting(path string) bool {
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if c < 0x20 || c >= 0x7f || c == '"' || c == '\\' {
+			return true
+		}
+	}
+	return false
+}
+
+// Stream hands long-running commands to the fallback backend, since none of them
+// can be answered in-process.
+func (b *NativeBackend) Stream(ctx context.Context, dir string, output func(OutputLine), args ...string) error {
+	return streamCommand(ctx, b.fallback, dir, output, args...)
+}
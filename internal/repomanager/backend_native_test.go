@@ -0,0 +1,182 @@
+package repomanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// countingBackend counts the commands that reach the wrapped backend.
+type countingBackend struct {
+	GitBackend
+	calls atomic.Int64
+}
+
+func (b *countingBackend) Run(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	b.calls.Add(1)
+	return b.GitBackend.Run(ctx, dir, args...)
+}
+
+// compareWithExec runs a command through the native and exec backends, fails
+// the test when the outputs differ and returns how often the native backend fell back.
+func compareWithExec(t *testing.T, dir string, args ...string) int64 {
+	t.Helper()
+
+	fallback := &countingBackend{GitBackend: NewExecBackend()}
+	got, err := NewNativeBackend(fallback).Run(context.Background(), dir, args...)
+	if err != nil {
+		t.Fatalf("native %s: %v", strings.Join(args, " "), err)
+	}
+
+	want, err := NewExecBackend().Run(context.Background(), dir, args...)
+	if err != nil {
+		t.Fatalf("exec %s: %v", strings.Join(args, " "), err)
+	}
+
+	if string(got) != string(want) {
+		t.Errorf("native output differs from git for %s\nnative:\n%s\ngit:\n%s", strings.Join(args, " "), got, want)
+	}
+	return fallback.calls.Load()
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNativeBackend_StatusMatchesGit(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+
+	other := filepath.Join(root, "other")
+	git(t, root, "clone", "-q", remote, other)
+	commitFile(t, other, "upstream.txt", "upstream\n")
+	git(t, other, "push", "-q", "origin", "main")
+	writeFile(t, filepath.Join(repo, ".gitignore"), "*.log\nbuild/\n!keep.log\n")
+	writeFile(t, filepath.Join(repo, "src", "main.go"), "package main\n")
+	writeFile(t, filepath.Join(repo, "src", ".gitignore"), "/generated\n")
+	writeFile(t, filepath.Join(repo, "script.sh"), "echo hi\n")
+	writeFile(t, filepath.Join(repo, "remove.txt"), "remove me\n")
+	if err := os.Symlink("README.md", filepath.Join(repo, "link")); err != nil {
+		t.Fatal(err)
+	}
+	git(t, repo, "add", "-A")
+	git(t, repo, "commit", "-q", "-m", "layout")
+	commitFile(t, repo, "local.txt", "local\n")
+	git(t, repo, "fetch", "-q")
+	git(t, repo, "gc", "-q")
+
+	statusArgs := []string{"status", "--porcelain=v2", "--branch"}
+
+	if calls := compareWithExec(t, repo, statusArgs...); calls != 0 {
+		t.Errorf("expected clean status to be read in-process, fell back %d times", calls)
+	}
+
+	// Worktree changes of every kind
+	writeFile(t, filepath.Join(repo, "README.md"), "changed\n")
+	writeFile(t, filepath.Join(repo, "src", "main.go"), "package main // x\n")
+	os.Remove(filepath.Join(repo, "remove.txt"))
+	os.Chmod(filepath.Join(repo, "script.sh"), 0o755)
+	os.Remove(filepath.Join(repo, "link"))
+	writeFile(t, filepath.Join(repo, "link"), "now a file\n")
+
+	// Untracked and ignored files
+	writeFile(t, filepath.Join(repo, "notes.txt"), "notes\n")
+	writeFile(t, filepath.Join(repo, "debug.log"), "ignored\n")
+	writeFile(t, filepath.Join(repo, "keep.log"), "re-included\n")
+	writeFile(t, filepath.Join(repo, "build", "out.bin"), "ignored dir\n")
+	writeFile(t, filepath.Join(repo, "docs", "guide", "intro.md"), "untracked dir\n")
+	writeFile(t, filepath.Join(repo, "logs", "only.log"), "only ignored content\n")
+	writeFile(t, filepath.Join(repo, "src", "generated", "code.go"), "ignored by nested file\n")
+	writeFile(t, filepath.Join(repo, "src", "new.go"), "package main\n")
+	os.MkdirAll(filepath.Join(repo, "empty"), 0o755)
+
+	if calls := compareWithExec(t, repo, statusArgs...); calls != 0 {
+		t.Errorf("expected dirty status to be read in-process, fell back %d times", calls)
+	}
+
+	// Staged changes are left to git
+	git(t, repo, "add", "notes.txt")
+	if calls := compareWithExec(t, repo, statusArgs...); calls != 1 {
+		t.Errorf("expected staged changes to fall back once, got %d", calls)
+	}
+}
+
+func TestNativeBackend_SpecialHeads(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	statusArgs := []string{"status", "--porcelain=v2", "--branch"}
+
+	detached := filepath.Join(root, "detached")
+	git(t, root, "clone", "-q", remote, detached)
+	git(t, detached, "checkout", "-q", "--detach")
+	if calls := compareWithExec(t, detached, statusArgs...); calls != 0 {
+		t.Errorf("expected detached HEAD to be read in-process, fell back %d times", calls)
+	}
+
+	unborn := filepath.Join(root, "unborn")
+	git(t, root, "init", "-q", "-b", "main", unborn)
+	writeFile(t, filepath.Join(unborn, "new.txt"), "new\n")
+	if calls := compareWithExec(t, unborn, statusArgs...); calls != 0 {
+		t.Errorf("expected unborn branch to be read in-process, fell back %d times", calls)
+	}
+}
+
+func TestNativeBackend_WorktreesAndLayout(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	git(t, remote, "worktree", "add", "-q", filepath.Join(root, "wt-main"), "main")
+	git(t, remote, "worktree", "add", "-q", "--detach", filepath.Join(root, "wt-detached"))
+	git(t, remote, "worktree", "add", "-q", "-b", "feature", filepath.Join(root, "wt-feature"))
+	git(t, remote, "worktree", "lock", "--reason", "on usb", filepath.Join(root, "wt-feature"))
+
+	if calls := compareWithExec(t, remote, "worktree", "list", "--porcelain"); calls != 0 {
+		t.Errorf("expected worktree list to be read in-process, fell back %d times", calls)
+	}
+
+	for _, path := range []string{remote, filepath.Join(root, "wt-main")} {
+		native := NewRepoManager(nil, NewNativeBackend(&countingBackend{GitBackend: NewExecBackend()}))
+		exec := NewRepoManager(nil, NewExecBackend())
+
+		got, err := native.readRepoInfo(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := exec.readRepoInfo(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("repository info for %s differs: native %+v, git %+v", path, got, want)
+		}
+	}
+}
+
+func TestNativeBackend_FallsBackForOtherCommands(t *testing.T) {
+	fake := NewFakeBackend()
+	fake.SetOutput("/repo", "ok", "log", "-1")
+
+	output, err := NewNativeBackend(fake).Run(context.Background(), "/repo", "log", "-1")
+	if err != nil || string(output) != "ok" {
+		t.Fatalf("expected fallback output, got %q, %v", output, err)
+	}
+	if calls := fake.Calls(); len(calls) != 1 {
+		t.Errorf("expected 1 fallback call, got %d", len(calls))
+	}
+}
@@ -0,0 +1,158 @@
+package repomanager
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Branch describes a local or remote-tracking branch of a repository.
+type Branch struct {
+	Name         string    // Short name, e.g. "main" or "origin/main"
+	Remote       bool      // Whether this is a remote-tracking branch
+	Upstream     string    // Upstream of a local branch, empty when none is configured
+	UpstreamGone bool      // Whether the configured upstream no longer exists
+	Ahead        int       // Commits not on the upstream
+	Behind       int       // Upstream commits not on the branch
+	Head         bool      // Whether the branch is checked out in the repository or worktree itself
+	Worktree     string    // Path of the worktree the branch is checked out in, empty when none
+	Merged       bool      // Whether deleting the local branch loses no commits
+	LastCommit   time.Time // Committer date of the branch tip
+}
+
+// branchFormat prints one ref per line with fields separated by the unit separator.
+const branchFormat = "--format=%(refname)%1f%(refname:short)%1f%(upstream:short)%1f%(upstream:track,nobracket)%1f%(HEAD)%1f%(worktreepath)%1f%(committerdate:unix)%1f%(symref)"
+
+// ListBranches returns the local branches followed by the remote-tracking branches,
+// each sorted by name. A local branch counts as merged when its upstream holds all
+// of its commits or it is reachable from HEAD, the same rules git branch -d applies.
+func (rm *RepoManager) ListBranches(path string) ([]Branch, error) {
+	output, err := rm.runGitCommand(path, "for-each-ref", branchFormat, "refs/heads", "refs/remotes")
+	if err != nil {
+		return nil, err
+	}
+	branches := parseBranchList(string(output))
+
+	// An unborn HEAD has nothing merged into it
+	merged := make(map[string]bool)
+	if output, err := rm.runGitCommand(path, "for-each-ref", "--format=%(refname:short)", "--merged", "HEAD", "refs/heads"); err == nil {
+		for _, name := range strings.Fields(string(output)) {
+			merged[name] = true
+		}
+	}
+	for i := range branches {
+		branch := &branches[i]
+		if branch.Remote {
+			continue
+		}
+		branch.Merged = merged[branch.Name] || (branch.Upstream != "" && !branch.UpstreamGone && branch.Ahead == 0)
+	}
+
+	return branches, nil
+}
+
+// parseBranchList parses the output of git for-each-ref using branchFormat.
+// Symbolic refs such as origin/HEAD are left out.
+func parseBranchList(output string) []Branch {
+	var branches []Branch
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, "\x1f")
+		if len(fields) < 8 || fields[7] != "" {
+			continue
+		}
+
+		branch := Branch{
+			Name:     fields[1],
+			Remote:   strings.HasPrefix(fields[0], "refs/remotes/"),
+			Upstream: fields[2],
+			Head:     fields[4] == "*",
+			Worktree: fields[5],
+		}
+		branch.Ahead, branch.Behind, branch.UpstreamGone = parseTrack(fields[3])
+		if seconds, err := strconv.ParseInt(fields[6], 10, 64); err == nil {
+			branch.LastCommit = time.Unix(seconds, 0)
+		}
+
+		branches = append(branches, branch)
+	}
+
+	sort.SliceStable(branches, func(i, j int) bool {
+		if branches[i].Remote != branches[j].Remote {
+			return !branches[i].Remote
+		}
+		return branches[i].Name < branches[j].Name
+	})
+	return branches
+}
+
+// parseTrack parses an upstream tracking summary such as "ahead 1, behind 2" or "gone".
+func parseTrack(track string) (ahead, behind int, gone bool) {
+	if track == "gone" {
+		return 0, 0, true
+	}
+
+	for _, part := range strings.Split(track, ", ") {
+		if count, ok := strings.CutPrefix(part, "ahead "); ok {
+			ahead, _ = strconv.Atoi(count)
+		} else if count, ok := strings.CutPrefix(part, "behind "); ok {
+			behind, _ = strconv.Atoi(count)
+		}
+	}
+	return ahead, behind, false
+}
+
+// CheckoutBranch checks out a branch in the working tree at path. Checking out a
+// remote-tracking branch creates a local branch of the same name that tracks it.
+func (rm *RepoManager) CheckoutBranch(path string, branch Branch) error {
+	if branch.Remote {
+		_, err := rm.runGitCommand(path, "switch", "--track", branch.Name)
+		return err
+	}
+	_, err := rm.runGitCommand(path, "switch", branch.Name)
+	return err
+}
+
+// CreateBranch creates a local branch named name that starts at the tip of start,
+// without checking it out. Starting from a remote-tracking branch sets it as upstream.
+func (rm *RepoManager) CreateBranch(path, name string, start Branch) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("branch name is empty")
+	}
+	_, err := rm.runGitCommand(path, "branch", name, start.Name)
+	return err
+}
+
+// RenameBranch renames a local branch, keeping its upstream and reflog.
+func (rm *RepoManager) RenameBranch(path string, branch Branch, name string) error {
+	if branch.Remote {
+		return fmt.Errorf("%s is a remote-tracking branch", branch.Name)
+	}
+	if strings.TrimSpace(name) == "" {
+		return errors.New("branch name is empty")
+	}
+	_, err := rm.runGitCommand(path, "branch", "--move", branch.Name, name)
+	return err
+}
+
+// DeleteBranch deletes a local branch. Without force git refuses to delete a
+// branch whose commits would be lost; force deletes it regardless. Branches
+// checked out in a worktree are never deleted.
+func (rm *RepoManager) DeleteBranch(path string, branch Branch, force bool) error {
+	if branch.Remote {
+		return fmt.Errorf("%s is a remote-tracking branch", branch.Name)
+	}
+	if branch.Worktree != "" {
+		return fmt.Errorf("%s is checked out in %s", branch.Name, branch.Worktree)
+	}
+
+	flag := "--delete"
+	if force {
+		flag = "-D"
+	}
+	_, err := rm.runGitCommand(path, "branch", flag, branch.Name)
+	return err
+}
@@ -0,0 +1,88 @@
+package repomanager
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestParseBranchList(t *testing.T) {
+	output := "refs/remotes/origin/HEAD\x1forigin\x1f\x1f\x1f \x1f\x1f1700000000\x1frefs/remotes/origin/main\n" +
+		"refs/remotes/origin/main\x1forigin/main\x1f\x1f\x1f \x1f\x1f1700000000\x1f\n" +
+		"refs/heads/old\x1fold\x1forigin/old\x1fgone\x1f \x1f\x1f1600000000\x1f\n" +
+		"refs/heads/main\x1fmain\x1forigin/main\x1fahead 1, behind 2\x1f*\x1f/work\x1f1700000000\x1f\n"
+
+	branches := parseBranchList(output)
+	if len(branches) != 3 {
+		t.Fatalf("got %d branches, want 3: %+v", len(branches), branches)
+	}
+
+	main := branches[0]
+	if main.Name != "main" || main.Remote || !main.Head || main.Worktree != "/work" || main.Ahead != 1 || main.Behind != 2 {
+		t.Errorf("unexpected main branch %+v", main)
+	}
+	if old := branches[1]; old.Name != "old" || !old.UpstreamGone || old.LastCommit.Unix() != 1600000000 {
+		t.Errorf("unexpected old branch %+v", old)
+	}
+	if remote := branches[2]; remote.Name != "origin/main" || !remote.Remote {
+		t.Errorf("unexpected remote branch %+v", remote)
+	}
+}
+
+func TestBranchActions(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	work := filepath.Join(root, "work")
+	git(t, root, "clone", "-q", remote, work)
+	git(t, work, "branch", "merged")
+	git(t, work, "switch", "-q", "-c", "unmerged")
+	commitFile(t, work, "feature.txt", "feature\n")
+	git(t, work, "switch", "-q", "main")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{work}})
+	branches, err := rm.ListBranches(work)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]Branch)
+	for _, branch := range branches {
+		byName[branch.Name] = branch
+	}
+	if main := byName["main"]; !main.Head || main.Upstream != "origin/main" || main.Worktree != work {
+		t.Errorf("unexpected main branch %+v", main)
+	}
+	if !byName["merged"].Merged || byName["unmerged"].Merged {
+		t.Errorf("merged = %v, unmerged = %v", byName["merged"].Merged, byName["unmerged"].Merged)
+	}
+
+	if err := rm.DeleteBranch(work, byName["main"], true); err == nil {
+		t.Error("expected deleting the checked out branch to fail")
+	}
+	if err := rm.DeleteBranch(work, byName["unmerged"], false); err == nil {
+		t.Error("expected deleting an unmerged branch without force to fail")
+	}
+	if err := rm.DeleteBranch(work, byName["unmerged"], true); err != nil {
+		t.Errorf("force delete: %v", err)
+	}
+	if err := rm.RenameBranch(work, byName["merged"], "renamed"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.CreateBranch(work, "topic", byName["origin/main"]); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, work, "rev-parse", "--abbrev-ref", "topic@{upstream}"); got != "origin/main" {
+		t.Errorf("upstream of a branch created from a remote branch = %q", got)
+	}
+	if err := rm.CheckoutBranch(work, Branch{Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, work, "branch", "--show-current"); got != "renamed" {
+		t.Errorf("checked out branch = %q", got)
+	}
+	if got := git(t, work, "branch", "--format=%(refname:short)"); got != "main\nrenamed\ntopic" {
+		t.Errorf("local branches:\n%s", got)
+	}
+}
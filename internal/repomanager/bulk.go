@@ -0,0 +1,29 @@
+package repomanager
+
+import "context"
+
+// BulkResult reports the outcome of a bulk operation on a single repository or worktree.
+type BulkResult struct {
+	Path string
+	Err  error
+}
+
+// RunBulk calls op for every path with bounded concurrency and returns the results
+// in the order of paths. When done is not nil it is called as soon as a path finishes,
+// from the goroutine that ran it. Paths that were not started before ctx was cancelled
+// report the context error.
+func (rm *RepoManager) RunBulk(ctx context.Context, paths []string, op func(ctx context.Context, path string) error, done func(BulkResult)) []BulkResult {
+	results := make([]BulkResult, len(paths))
+	runBounded(len(paths), rm.concurrency, func(i int) {
+		err := ctx.Err()
+		if err == nil {
+			err = op(ctx, paths[i])
+		}
+
+		results[i] = BulkResult{Path: paths[i], Err: err}
+		if done != nil {
+			done(results[i])
+		}
+	})
+	return results
+}
@@ -0,0 +1,75 @@
+package repomanager
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestRunBulk(t *testing.T) {
+	rm := NewRepoManager(nil, NewFakeBackend())
+	paths := []string{"/a", "/b", "/c"}
+	failure := errors.New("rejected")
+
+	var mu sync.Mutex
+	reported := make(map[string]error)
+	results := rm.RunBulk(context.Background(), paths, func(_ context.Context, path string) error {
+		if path == "/b" {
+			return failure
+		}
+		return nil
+	}, func(result BulkResult) {
+		mu.Lock()
+		reported[result.Path] = result.Err
+		mu.Unlock()
+	})
+
+	if len(results) != len(paths) || len(reported) != len(paths) {
+		t.Fatalf("got %d results and %d reports, want %d", len(results), len(reported), len(paths))
+	}
+	for i, result := range results {
+		if result.Path != paths[i] {
+			t.Errorf("result %d is for %s, want %s", i, result.Path, paths[i])
+		}
+		if wantErr := result.Path == "/b"; (result.Err != nil) != wantErr || reported[result.Path] != result.Err {
+			t.Errorf("unexpected outcome for %s: %v", result.Path, result.Err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results = rm.RunBulk(ctx, paths, func(context.Context, string) error {
+		t.Error("expected no operation to start after cancellation")
+		return nil
+	}, nil)
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("expected %s to report the cancellation, got %v", result.Path, result.Err)
+		}
+	}
+}
+
+func TestFetch_RecordsTrackedItem(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	if err := rm.Fetch(context.Background(), repo); err != nil {
+		t.Fatal(err)
+	}
+	if findItem(t, rm, repo).LastFetch.IsZero() {
+		t.Error("expected the fetch to be recorded on the item")
+	}
+
+	if err := rm.Fetch(context.Background(), filepath.Join(root, "missing")); err == nil {
+		t.Error("expected fetching a missing path to fail")
+	}
+}
@@ -0,0 +1,157 @@
+package repomanager
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// defaultStaleDays is used when the configured number of stale days is not positive.
+const defaultStaleDays = 90
+
+// CleanupBranch is a local branch the cleanup report suggests deleting.
+type CleanupBranch struct {
+	RepoPath      string
+	RepoName      string
+	DefaultBranch string // Branch the merge check compared against
+	Branch        Branch
+	Merged        bool // Whether the branch is fully merged into the default branch
+	Gone          bool // Whether the upstream of the branch no longer exists
+	Stale         bool // Whether the branch has no commits for the configured number of days
+}
+
+// CleanupReport lists the branches that can be cleaned up across all tracked repositories.
+type CleanupReport struct {
+	Branches  []CleanupBranch
+	StaleDays int
+	Failed    []BulkResult // Repositories whose branches could not be read
+}
+
+// StaleDays returns the number of days without commits after which a branch is stale.
+func (rm *RepoManager) StaleDays() int {
+	if rm.cleanup.StaleDays > 0 {
+		return rm.cleanup.StaleDays
+	}
+	return defaultStaleDays
+}
+
+// ReadCleanupReport collects merged, gone and stale local branches of every
+// accessible repository with bounded concurrency. Worktrees share the branches of
+// their repository, so only top-level items are read. The default branch itself
+// and branches checked out in a worktree are never listed.
+func (rm *RepoManager) ReadCleanupReport() CleanupReport {
+	var items []*RepoItem
+	for _, item := range rm.GetItems() {
+		if !item.HasError {
+			items = append(items, item)
+		}
+	}
+
+	staleDays := rm.StaleDays()
+	cutoff := time.Now().AddDate(0, 0, -staleDays)
+
+	perRepo := make([][]CleanupBranch, len(items))
+	errs := make([]error, len(items))
+	runBounded(len(items), rm.concurrency, func(i int) {
+		perRepo[i], errs[i] = rm.cleanupBranches(items[i], cutoff)
+	})
+
+	report := CleanupReport{StaleDays: staleDays}
+	for i, item := range items {
+		if errs[i] != nil {
+			report.Failed = append(report.Failed, BulkResult{Path: item.Path, Err: errs[i]})
+			continue
+		}
+		report.Branches = append(report.Branches, perRepo[i]...)
+	}
+	return report
+}
+
+// cleanupBranches returns the local branches of a repository that are merged into
+// its default branch, lost their upstream or had no commits since cutoff.
+func (rm *RepoManager) cleanupBranches(item *RepoItem, cutoff time.Time) ([]CleanupBranch, error) {
+	defaultBranch, err := rm.defaultBranch(item.Path)
+	if err != nil {
+		return nil, err
+	}
+	branches, err := rm.ListBranches(item.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := rm.runGitCommand(item.Path, "for-each-ref", "--format=%(refname:short)", "--merged", defaultBranch, "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+	merged := make(map[string]bool)
+	for _, name := range strings.Fields(string(output)) {
+		merged[name] = true
+	}
+
+	var result []CleanupBranch
+	for _, branch := range branches {
+		if branch.Remote || branch.Name == defaultBranch || branch.Worktree != "" {
+			continue
+		}
+
+		candidate := CleanupBranch{
+			RepoPath:      item.Path,
+			RepoName:      item.Name,
+			DefaultBranch: defaultBranch,
+			Branch:        branch,
+			Merged:        merged[branch.Name],
+			Gone:          branch.UpstreamGone,
+			Stale:         !branch.LastCommit.IsZero() && branch.LastCommit.Before(cutoff),
+		}
+		if candidate.Merged || candidate.Gone || candidate.Stale {
+			result = append(result, candidate)
+		}
+	}
+	return result, nil
+}
+
+// defaultBranch returns the configured default branch, or the branch origin/HEAD
+// points to, falling back to main or master and finally to the branch HEAD points to.
+// The remote-tracking branch is used when no local branch of that name exists.
+func (rm *RepoManager) defaultBranch(path string) (string, error) {
+	if rm.cleanup.DefaultBranch != "" {
+		return rm.cleanup.DefaultBranch, nil
+	}
+
+	if output, err := rm.runGitCommand(path, "symbolic-ref", "--quiet", "--short", "refs/remotes/origin/HEAD"); err == nil {
+		remote := strings.TrimSpace(string(output))
+		if local := strings.TrimPrefix(remote, "origin/"); rm.branchExists(path, local) {
+			return local, nil
+		}
+		return remote, nil
+	}
+
+	for _, name := range []string{"main", "master"} {
+		if rm.branchExists(path, name) {
+			return name, nil
+		}
+	}
+
+	output, err := rm.runGitCommand(path, "symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return "", errors.New("cannot determine the default branch")
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// branchExists reports whether a local branch of the given name exists.
+func (rm *RepoManager) branchExists(path, name string) bool {
+	_, err := rm.runGitCommand(path, "rev-parse", "--verify", "--quiet", "refs/heads/"+name)
+	return err == nil
+}
+
+// DeleteBranches force deletes local branches of the repository at path in a single
+// git call, so that deletions in the same repository never race for its ref locks.
+func (rm *RepoManager) DeleteBranches(ctx context.Context, path string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	_, err := rm.runGitCommandContext(ctx, path, append([]string{"branch", "-D"}, names...)...)
+	return err
+}
@@ -0,0 +1,70 @@
+package repomanager
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestReadCleanupReport(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	work := filepath.Join(root, "work")
+	git(t, root, "clone", "-q", remote, work)
+
+	// merged: fully merged into main
+	git(t, work, "branch", "merged")
+
+	// gone: pushed, then deleted on the remote
+	git(t, work, "switch", "-q", "-c", "gone")
+	commitFile(t, work, "gone.txt", "gone\n")
+	git(t, work, "push", "-q", "-u", "origin", "gone")
+	git(t, work, "push", "-q", "origin", "--delete", "gone")
+	git(t, work, "fetch", "-q", "--prune")
+
+	// stale: last commit long ago
+	git(t, work, "switch", "-q", "-c", "stale", "main")
+	t.Setenv("GIT_COMMITTER_DATE", "2001-01-01T00:00:00")
+	commitFile(t, work, "stale.txt", "stale\n")
+	t.Setenv("GIT_COMMITTER_DATE", "")
+
+	// active: recent unmerged work, and a branch checked out in a worktree
+	git(t, work, "switch", "-q", "-c", "active", "main")
+	commitFile(t, work, "active.txt", "active\n")
+	git(t, work, "switch", "-q", "main")
+	git(t, work, "worktree", "add", "-q", filepath.Join(root, "checked-out"), "-b", "checked-out")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{work}, Cleanup: config.CleanupConfig{StaleDays: 30}})
+	report := rm.ReadCleanupReport()
+	if len(report.Failed) != 0 {
+		t.Fatalf("unexpected failures %+v", report.Failed)
+	}
+
+	got := make(map[string]CleanupBranch)
+	for _, branch := range report.Branches {
+		got[branch.Branch.Name] = branch
+	}
+	if len(got) != 3 {
+		t.Fatalf("got branches %v, want merged, gone and stale", got)
+	}
+	if branch := got["merged"]; !branch.Merged || branch.DefaultBranch != "main" {
+		t.Errorf("unexpected merged branch %+v", branch)
+	}
+	if branch := got["gone"]; !branch.Gone || branch.Merged {
+		t.Errorf("unexpected gone branch %+v", branch)
+	}
+	if branch := got["stale"]; !branch.Stale || branch.Merged {
+		t.Errorf("unexpected stale branch %+v", branch)
+	}
+
+	if err := rm.DeleteBranches(context.Background(), work, []string{"gone", "stale"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, work, "branch", "--format=%(refname:short)"); got != "active\nchecked-out\nmain\nmerged" {
+		t.Errorf("local branches after delete:\n%s", got)
+	}
+}
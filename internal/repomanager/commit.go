@@ -0,0 +1,114 @@
+package repomanager
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CommitInfo describes the commit HEAD points to.
+type CommitInfo struct {
+	Hash    string    // Abbreviated commit hash
+	Subject string    // First line of the commit message
+	Author  string    // Author name
+	Time    time.Time // Committer date
+}
+
+// IsZero reports whether no commit information is available, e.g. on an unborn branch.
+func (c CommitInfo) IsZero() bool {
+	return c.Hash == ""
+}
+
+// commitFormat prints the full hash, abbreviated hash, author, committer timestamp
+// and subject separated by the unit separator.
+const commitFormat = "--format=%H%x1f%h%x1f%an%x1f%ct%x1f%s"
+
+// readLastCommit reads the commit HEAD points to and returns it with its full hash.
+// Repositories without commits yield an empty CommitInfo.
+func (rm *RepoManager) readLastCommit(path string) (CommitInfo, string) {
+	output, err := rm.runGitCommand(path, "log", "-1", commitFormat)
+	if err != nil {
+		return CommitInfo{}, ""
+	}
+	return parseCommitInfo(strings.TrimSuffix(string(output), "\n"))
+}
+
+// parseCommitInfo parses a single line of output printed with commitFormat.
+func parseCommitInfo(line string) (CommitInfo, string) {
+	fields := strings.SplitN(line, "\x1f", 5)
+	if len(fields) < 5 {
+		return CommitInfo{}, ""
+	}
+
+	commit := CommitInfo{
+		Hash:    fields[1],
+		Author:  fields[2],
+		Subject: fields[4],
+	}
+	if seconds, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+		commit.Time = time.Unix(seconds, 0)
+	}
+	return commit, fields[0]
+}
+
+// lastCommitFor returns the commit for a freshly read HEAD, reusing the cached
+// commit when HEAD did not move since it was read.
+func (rm *RepoManager) lastCommitFor(path, head, cachedOid string, cached CommitInfo) (CommitInfo, string) {
+	if head != "" && head == cachedOid {
+		return cached, cachedOid
+	}
+	return rm.readLastCommit(path)
+}
+
+// LatestCommitTime returns the time of the most recent commit checked out in the
+// repository or any of its worktrees, or the zero time when there is none.
+func (item *RepoItem) LatestCommitTime() time.Time {
+	latest := item.LastCommit.Time
+	for _, subItem := range item.SubItems {
+		if subItem.LastCommit.Time.After(latest) {
+			latest = subItem.LastCommit.Time
+		}
+	}
+	return latest
+}
+
+// CommitOptions controls how CreateCommit records the staged changes.
+type CommitOptions struct {
+	Message string // Full commit message, the first line is the subject
+	Amend   bool   // Replace the commit HEAD points to instead of adding a new one
+	SignOff bool   // Append a Signed-off-by trailer for the committer
+}
+
+// CreateCommit commits the staged changes of the working tree at path and returns
+// the commit HEAD points to afterwards.
+func (rm *RepoManager) CreateCommit(path string, opts CommitOptions) (CommitInfo, error) {
+	if strings.TrimSpace(opts.Message) == "" {
+		return CommitInfo{}, errors.New("commit message is empty")
+	}
+
+	args := []string{"commit", "--message", opts.Message}
+	if opts.Amend {
+		args = append(args, "--amend")
+	}
+	if opts.SignOff {
+		args = append(args, "--signoff")
+	}
+
+	if _, err := rm.runGitCommand(path, args...); err != nil {
+		return CommitInfo{}, err
+	}
+
+	commit, _ := rm.readLastCommit(path)
+	return commit, nil
+}
+
+// ReadCommitMessage returns the full message of the commit HEAD points to,
+// used to prefill the message when amending.
+func (rm *RepoManager) ReadCommitMessage(path string) (string, error) {
+	output, err := rm.runGitCommand(path, "log", "-1", "--format=%B")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,59 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestCreateCommit(t *testing.T) {
+	isolateGit(t)
+
+	repo := filepath.Join(t.TempDir(), "repo")
+	git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "file.txt", "one\n")
+
+	if err := os.WriteFile(filepath.Join(repo, "file.txt"), []byte("two\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, repo, "add", "file.txt")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+
+	if _, err := rm.CreateCommit(repo, CommitOptions{Message: "  \n"}); err == nil {
+		t.Fatal("expected an empty message to be refused")
+	}
+
+	commit, err := rm.CreateCommit(repo, CommitOptions{Message: "Change file\n\nLonger body.", SignOff: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commit.Subject != "Change file" || commit.Hash != git(t, repo, "rev-parse", "--short", "HEAD") {
+		t.Errorf("unexpected commit %+v", commit)
+	}
+
+	message, err := rm.ReadCommitMessage(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Change file\n\nLonger body.\n\nSigned-off-by: Test <test@example.com>"; message != want {
+		t.Errorf("message = %q, want %q", message, want)
+	}
+
+	if _, err := rm.CreateCommit(repo, CommitOptions{Message: "Reworded", Amend: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, repo, "rev-list", "--count", "HEAD"); got != "2" {
+		t.Errorf("expected amending to keep 2 commits, got %s", got)
+	}
+	if got := git(t, repo, "log", "-1", "--format=%s"); got != "Reworded" {
+		t.Errorf("subject after amend = %q", got)
+	}
+
+	// git reports a clean tree on stdout, so the error falls back to the exit status
+	if _, err := rm.CreateCommit(repo, CommitOptions{Message: "Nothing"}); err == nil {
+		t.Error("expected committing without staged changes to fail")
+	}
+}
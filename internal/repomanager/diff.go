@@ -0,0 +1,94 @@
+package repomanager
+
+import (
+	"os"
+	"strings"
+)
+
+// DiffLineKind classifies a line of a unified diff for coloring.
+type DiffLineKind int
+
+const (
+	DiffContext DiffLineKind = iota
+	DiffAdded
+	DiffRemoved
+	DiffHunk   // "@@ -a,b +c,d @@" hunk header
+	DiffHeader // File header such as "diff --git", "index" or "+++"
+)
+
+// DiffLine is a single line of a unified diff.
+type DiffLine struct {
+	Kind DiffLineKind
+	Text string
+}
+
+// Diff is a parsed unified diff.
+type Diff struct {
+	Lines []DiffLine
+	Hunks []int // Indexes of the hunk header lines in Lines
+}
+
+// DiffOptions selects what ReadDiff compares.
+type DiffOptions struct {
+	File      string // Path relative to the repository root, empty for the whole working tree
+	Staged    bool   // Compare the index with HEAD instead of the working tree with the index
+	Untracked bool   // File is untracked, so its unstaged diff shows it as added in full
+}
+
+// ReadDiff reads the staged or unstaged changes of a file or the whole working tree at path.
+func (rm *RepoManager) ReadDiff(path string, opts DiffOptions) (Diff, error) {
+	if opts.Untracked && !opts.Staged && opts.File != "" {
+		// git diff --no-index exits with status 1 whenever the files differ
+		output, err := rm.runGitCommand(path, "diff", "--no-color", "--no-index", "--", os.DevNull, opts.File)
+		if err != nil && len(output) == 0 {
+			return Diff{}, err
+		}
+		return parseDiff(string(output)), nil
+	}
+
+	args := []string{"diff", "--no-color", "--no-ext-diff"}
+	if opts.Staged {
+		args = append(args, "--cached")
+	}
+	if opts.File != "" {
+		args = append(args, "--", opts.File)
+	}
+
+	output, err := rm.runGitCommand(path, args...)
+	if err != nil {
+		return Diff{}, err
+	}
+	return parseDiff(string(output)), nil
+}
+
+// parseDiff splits unified diff output into classified lines.
+func parseDiff(output string) Diff {
+	var diff Diff
+	if output == "" {
+		return diff
+	}
+
+	inHunk := false
+	for _, text := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		line := DiffLine{Text: text}
+
+		switch {
+		case strings.HasPrefix(text, "diff "):
+			inHunk = false
+			line.Kind = DiffHeader
+		case strings.HasPrefix(text, "@@"):
+			inHunk = true
+			line.Kind = DiffHunk
+			diff.Hunks = append(diff.Hunks, len(diff.Lines))
+		case !inHunk:
+			line.Kind = DiffHeader
+		case strings.HasPrefix(text, "+"):
+			line.Kind = DiffAdded
+		case strings.HasPrefix(text, "-"):
+			line.Kind = DiffRemoved
+		}
+
+		diff.Lines = append(diff.Lines, line)
+	}
+	return diff
+}
@@ -0,0 +1,67 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestReadDiff(t *testing.T) {
+	isolateGit(t)
+
+	repo := filepath.Join(t.TempDir(), "repo")
+	git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "file.txt", "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n")
+
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Staged: first line changed. Unstaged on top: last line changed too, far enough for a second hunk
+	write("file.txt", "ONE\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n")
+	git(t, repo, "add", "file.txt")
+	write("file.txt", "ONE\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nTEN\n")
+	write("new.txt", "brand new\n")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	count := func(diff Diff, kind DiffLineKind) int {
+		n := 0
+		for _, line := range diff.Lines {
+			if line.Kind == kind {
+				n++
+			}
+		}
+		return n
+	}
+
+	staged, err := rm.ReadDiff(repo, DiffOptions{File: "file.txt", Staged: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(staged.Hunks) != 1 || count(staged, DiffAdded) != 1 || count(staged, DiffRemoved) != 1 {
+		t.Errorf("unexpected staged diff %+v", staged)
+	}
+	if staged.Lines[staged.Hunks[0]+2].Text != "+ONE" {
+		t.Errorf("expected the staged hunk to replace the first line, got %+v", staged.Lines)
+	}
+
+	unstaged, err := rm.ReadDiff(repo, DiffOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unstaged.Hunks) != 1 || count(unstaged, DiffAdded) != 1 || count(unstaged, DiffHeader) != 4 {
+		t.Errorf("unexpected unstaged diff %+v", unstaged)
+	}
+
+	untracked, err := rm.ReadDiff(repo, DiffOptions{File: "new.txt", Untracked: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(untracked.Hunks) != 1 || count(untracked, DiffAdded) != 1 || untracked.Lines[len(untracked.Lines)-1].Text != "+brand new" {
+		t.Errorf("unexpected untracked diff %+v", untracked)
+	}
+}
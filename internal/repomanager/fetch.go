@@ -0,0 +1,176 @@
+package repomanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// FetchResult reports the outcome of fetching a single repository.
+type FetchResult struct {
+	Path string
+	Err  error
+}
+
+// FetchEnabled reports whether background fetching is configured.
+func (rm *RepoManager) FetchEnabled() bool {
+	return rm.fetchConfig.Enabled
+}
+
+// FetchAll fetches the remotes of every accessible repository with bounded concurrency.
+// Worktrees share their parent's remotes, so only top-level items are fetched.
+func (rm *RepoManager) FetchAll(ctx context.Context) []FetchResult {
+	var items []*RepoItem
+	rm.mu.RLock()
+	for _, item := range rm.items {
+		if !item.HasError {
+			items = append(items, item)
+		}
+	}
+	rm.mu.RUnlock()
+
+	results := make([]FetchResult, len(items))
+	runBounded(len(items), rm.fetchConfig.Concurrency, func(i int) {
+		results[i] = rm.fetchItem(ctx, items[i])
+	})
+	return results
+}
+
+// Fetch fetches all remotes of a repository or worktree. Fetching a tracked
+// repository records the outcome on its item, like a background fetch would.
+func (rm *RepoManager) Fetch(ctx context.Context, path string) error {
+	for _, item := range rm.sharedItems() {
+		if item.Path == path {
+			return rm.fetchItem(ctx, item).Err
+		}
+	}
+
+	_, err := rm.runGitCommandContext(ctx, path, "fetch", "--all", "--prune", "--quiet")
+	return err
+}
+
+// fetchItem fetches all remotes of a single repository and records the outcome on the item.
+func (rm *RepoManager) fetchItem(ctx context.Context, item *RepoItem) FetchResult {
+	timeout := rm.fetchConfig.Timeout
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	_, err := rm.runGitCommandContext(ctx, item.Path, "fetch", "--all", "--prune", "--quiet")
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("fetch timed out after %s", timeout)
+	}
+
+	now := time.Now()
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if err != nil {
+		item.FetchError = err.Error()
+	} else {
+		item.FetchError = ""
+		item.LastFetch = now
+	}
+	item.FetchStale = rm.isFetchStale(item, now)
+
+	return FetchResult{Path: item.Path, Err: err}
+}
+
+// loadLastFetch seeds the last fetch time from FETCH_HEAD so manual fetches count as well.
+func (rm *RepoManager) loadLastFetch(item *RepoItem) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if item.gitDir != "" {
+		if info, err := os.Stat(filepath.Join(item.gitDir, "FETCH_HEAD")); err == nil {
+			item.LastFetch = info.ModTime()
+		}
+	}
+	item.FetchStale = rm.isFetchStale(item, time.Now())
+}
+
+// isFetchStale reports whether the remote state of an item is too old to trust.
+// Staleness is only reported when background fetching is enabled.
+func (rm *RepoManager) isFetchStale(item *RepoItem, now time.Time) bool {
+	if !rm.fetchConfig.Enabled || item.HasError {
+		return false
+	}
+	if item.LastFetch.IsZero() {
+		return true
+	}
+	return now.Sub(item.LastFetch) > rm.fetchConfig.StaleThreshold()
+}
+
+// FetchScheduler periodically fetches all repositories in the background.
+type FetchScheduler struct {
+	repoManager *RepoManager
+	interval    time.Duration
+	rounds      chan []FetchResult
+	cancel      context.CancelFunc
+	once        sync.Once
+}
+
+// NewFetchScheduler creates a scheduler using the fetch settings of the given config.
+func NewFetchScheduler(repoManager *RepoManager, fetchConfig config.FetchConfig) *FetchScheduler {
+	return &FetchScheduler{
+		repoManager: repoManager,
+		interval:    fetchConfig.EffectiveInterval(),
+		rounds:      make(chan []FetchResult, 1),
+	}
+}
+
+// Start runs a fetch round immediately and then once per interval until Stop is called.
+// Calling Start more than once has no effect.
+func (s *FetchScheduler) Start() {
+	s.once.Do(func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		s.cancel = cancel
+		go s.run(ctx)
+	})
+}
+
+// Stop cancels running fetches and stops scheduling new rounds.
+func (s *FetchScheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// Rounds returns a channel that receives the results of every completed round.
+// Rounds that finish while a previous result is still unread are dropped.
+func (s *FetchScheduler) Rounds() <-chan []FetchResult {
+	return s.rounds
+}
+
+// run performs fetch rounds until the context is cancelled.
+func (s *FetchScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		results := s.repoManager.FetchAll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case s.rounds <- results:
+		default:
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
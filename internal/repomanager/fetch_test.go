@@ -0,0 +1,135 @@
+package repomanager
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestFetchAll_UpdatesBehindCount(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	tracked := filepath.Join(root, "tracked")
+	other := filepath.Join(root, "other")
+	git(t, root, "clone", "-q", remote, tracked)
+	git(t, root, "clone", "-q", remote, other)
+
+	commitFile(t, other, "new.txt", "new\n")
+	git(t, other, "push", "-q", "origin", "main")
+
+	rm := newTestManager(t, &config.Config{
+		RepositoryPaths: []string{tracked},
+		Fetch:           config.FetchConfig{Enabled: true, Concurrency: 2, Timeout: 30 * time.Second},
+	})
+
+	item := findItem(t, rm, tracked)
+	if item.BehindCount != 0 {
+		t.Fatalf("expected no commits behind before fetch, got %d", item.BehindCount)
+	}
+
+	results := rm.FetchAll(context.Background())
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("unexpected fetch results: %+v", results)
+	}
+	rm.ReloadStatus()
+
+	if item.BehindCount != 1 || !item.HasBehind {
+		t.Errorf("expected 1 commit behind after fetch, got %d", item.BehindCount)
+	}
+	if item.LastFetch.IsZero() {
+		t.Error("expected last fetch time to be recorded")
+	}
+	if item.FetchStale {
+		t.Error("expected item not to be stale right after a fetch")
+	}
+}
+
+func TestFetchAll_RecordsFailure(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+	git(t, repo, "remote", "set-url", "origin", filepath.Join(root, "missing.git"))
+
+	rm := newTestManager(t, &config.Config{
+		RepositoryPaths: []string{repo},
+		Fetch:           config.FetchConfig{Enabled: true, Timeout: 30 * time.Second},
+	})
+
+	results := rm.FetchAll(context.Background())
+	if len(results) != 1 || results[0].Err == nil {
+		t.Fatalf("expected a fetch error, got %+v", results)
+	}
+
+	item := findItem(t, rm, repo)
+	if item.FetchError == "" {
+		t.Error("expected fetch error to be recorded on the item")
+	}
+	if !item.FetchStale {
+		t.Error("expected never-fetched item to be stale")
+	}
+	if summary := rm.GetSummary(); summary.TotalStale != 1 {
+		t.Errorf("expected 1 stale repository in summary, got %d", summary.TotalStale)
+	}
+}
+
+func TestIsFetchStale(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		fetch     config.FetchConfig
+		lastFetch time.Time
+		expected  bool
+	}{
+		{"disabled", config.FetchConfig{Enabled: false}, time.Time{}, false},
+		{"never fetched", config.FetchConfig{Enabled: true, Interval: time.Minute}, time.Time{}, true},
+		{"recent fetch", config.FetchConfig{Enabled: true, Interval: time.Minute}, now.Add(-time.Minute), false},
+		{"older than three intervals", config.FetchConfig{Enabled: true, Interval: time.Minute}, now.Add(-4 * time.Minute), true},
+		{"default interval, recent fetch", config.FetchConfig{Enabled: true}, now.Add(-time.Minute), false},
+		{"default interval, older than three intervals", config.FetchConfig{Enabled: true}, now.Add(-20 * time.Minute), true},
+		{"explicit threshold", config.FetchConfig{Enabled: true, Interval: time.Minute, StaleAfter: time.Hour}, now.Add(-4 * time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm := &RepoManager{fetchConfig: tt.fetch}
+			item := &RepoItem{LastFetch: tt.lastFetch}
+			if result := rm.isFetchStale(item, now); result != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFetchScheduler_ReportsRounds(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+
+	fetchConfig := config.FetchConfig{Enabled: true, Interval: time.Hour, Timeout: 30 * time.Second}
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}, Fetch: fetchConfig})
+
+	scheduler := NewFetchScheduler(rm, fetchConfig)
+	scheduler.Start()
+	defer scheduler.Stop()
+
+	select {
+	case results := <-scheduler.Rounds():
+		if len(results) != 1 || results[0].Err != nil {
+			t.Fatalf("unexpected round results: %+v", results)
+		}
+	case <-time.After(30 * time.Second):
+		t.Fatal("timed out waiting for the first fetch round")
+	}
+}
@@ -0,0 +1,111 @@
+package repomanager
+
+import (
+	"slices"
+	"strings"
+)
+
+// FileGroup is the section of the changed-files list a file belongs to.
+type FileGroup int
+
+const (
+	FileConflicted FileGroup = iota
+	FileStaged
+	FileUnstaged
+	FileUntracked
+)
+
+// FileGroups lists the groups in display order.
+var FileGroups = []FileGroup{FileConflicted, FileStaged, FileUnstaged, FileUntracked}
+
+// String returns the heading of the group.
+func (g FileGroup) String() string {
+	switch g {
+	case FileConflicted:
+		return "Conflicted"
+	case FileStaged:
+		return "Staged"
+	case FileUnstaged:
+		return "Unstaged"
+	case FileUntracked:
+		return "Untracked"
+	}
+	return "Unknown"
+}
+
+// ChangedFile is a file with changes in the index or working tree. A file with both
+// staged and unstaged changes is listed once in each group, like git status does.
+type ChangedFile struct {
+	Path     string    // Path relative to the repository root
+	OrigPath string    // Path before a rename or copy, empty otherwise
+	Group    FileGroup // Section the file is listed in
+	Code     byte      // Porcelain status letter, e.g. 'M', 'A', 'D', 'R', 'U' or '?'
+}
+
+// ListChangedFiles returns the changed files of the working tree at path, sorted by group.
+func (rm *RepoManager) ListChangedFiles(path string) ([]ChangedFile, error) {
+	output, err := rm.runGitCommand(path, "status", "--porcelain=v2", "-z", "--untracked-files=all")
+	if err != nil {
+		return nil, err
+	}
+	return parseChangedFiles(string(output)), nil
+}
+
+// parseChangedFiles parses NUL-terminated git status --porcelain=v2 output.
+func parseChangedFiles(output string) []ChangedFile {
+	var files []ChangedFile
+
+	records := strings.Split(output, "\x00")
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+		if record == "" {
+			continue
+		}
+
+		switch record[0] {
+		case '1':
+			// 1 XY sub mH mI mW hH hI path
+			if fields := strings.SplitN(record, " ", 9); len(fields) == 9 {
+				files = appendXY(files, fields[1], fields[8], "")
+			}
+		case '2':
+			// 2 XY sub mH mI mW hH hI Xscore path, followed by the original path
+			if fields := strings.SplitN(record, " ", 10); len(fields) == 10 {
+				origPath := ""
+				if i+1 < len(records) {
+					i++
+					origPath = records[i]
+				}
+				files = appendXY(files, fields[1], fields[9], origPath)
+			}
+		case 'u':
+			// u XY sub m1 m2 m3 mW h1 h2 h3 path
+			if fields := strings.SplitN(record, " ", 11); len(fields) == 11 {
+				files = append(files, ChangedFile{Path: fields[10], Group: FileConflicted, Code: 'U'})
+			}
+		case '?':
+			files = append(files, ChangedFile{Path: record[2:], Group: FileUntracked, Code: '?'})
+		}
+	}
+
+	slices.SortStableFunc(files, func(a, b ChangedFile) int {
+		return int(a.Group) - int(b.Group)
+	})
+	return files
+}
+
+// appendXY adds the staged and unstaged entries described by an XY status field.
+func appendXY(files []ChangedFile, xy, path, origPath string) []ChangedFile {
+	if len(xy) < 2 {
+		return files
+	}
+
+	// "." means the side is unmodified
+	if xy[0] != '.' {
+		files = append(files, ChangedFile{Path: path, OrigPath: origPath, Group: FileStaged, Code: xy[0]})
+	}
+	if xy[1] != '.' {
+		files = append(files, ChangedFile{Path: path, Group: FileUnstaged, Code: xy[1]})
+	}
+	return files
+}
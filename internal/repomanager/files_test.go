@@ -0,0 +1,63 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestListChangedFiles(t *testing.T) {
+	isolateGit(t)
+
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	git(t, root, "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "both.txt", "one\n")
+	commitFile(t, repo, "old name.txt", "rename me\n")
+	commitFile(t, repo, "conflict.txt", "base\n")
+
+	git(t, repo, "checkout", "-q", "-b", "other")
+	commitFile(t, repo, "conflict.txt", "other\n")
+	git(t, repo, "checkout", "-q", "main")
+	commitFile(t, repo, "conflict.txt", "main\n")
+	conflict(t, repo, "merge", "other")
+
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(repo, name)), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("both.txt", "two\n")
+	git(t, repo, "add", "both.txt")
+	write("both.txt", "three\n")
+	git(t, repo, "mv", "old name.txt", "new name.txt")
+	write("dir/untracked.txt", "new\n")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	files, err := rm.ListChangedFiles(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ChangedFile{
+		{Path: "conflict.txt", Group: FileConflicted, Code: 'U'},
+		{Path: "both.txt", Group: FileStaged, Code: 'M'},
+		{Path: "new name.txt", OrigPath: "old name.txt", Group: FileStaged, Code: 'R'},
+		{Path: "both.txt", Group: FileUnstaged, Code: 'M'},
+		{Path: "dir/untracked.txt", Group: FileUntracked, Code: '?'},
+	}
+	if len(files) != len(want) {
+		t.Fatalf("got files %+v, want %+v", files, want)
+	}
+	for i := range want {
+		if files[i] != want[i] {
+			t.Errorf("file %d = %+v, want %+v", i, files[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package repomanager
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// StageFile adds the working tree state of a changed file to the index,
+// including deletions and marking conflicts as resolved.
+func (rm *RepoManager) StageFile(path string, file ChangedFile) error {
+	_, err := rm.runGitCommand(path, "add", "--all", "--", file.Path)
+	return err
+}
+
+// UnstageFile resets the index entry of a staged file to HEAD, keeping the working tree.
+// On an unborn branch there is no HEAD, so the file is removed from the index instead.
+func (rm *RepoManager) UnstageFile(path string, file ChangedFile) error {
+	paths := file.paths()
+
+	if _, err := rm.runGitCommand(path, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		_, err = rm.runGitCommand(path, append([]string{"rm", "--cached", "--quiet", "--"}, paths...)...)
+		return err
+	}
+
+	_, err := rm.runGitCommand(path, append([]string{"restore", "--staged", "--"}, paths...)...)
+	return err
+}
+
+// DiscardFile throws away the changes of a file in the group it is listed in.
+// Unstaged changes are reset to the index, untracked files are deleted, and staged
+// or conflicted files are reset to HEAD in both the index and the working tree.
+func (rm *RepoManager) DiscardFile(path string, file ChangedFile) error {
+	var args []string
+	switch file.Group {
+	case FileUnstaged:
+		args = []string{"restore", "--"}
+	case FileUntracked:
+		args = []string{"clean", "--force", "--quiet", "--"}
+	default:
+		args = []string{"restore", "--source=HEAD", "--staged", "--worktree", "--"}
+	}
+
+	_, err := rm.runGitCommand(path, append(args, file.paths()...)...)
+	return err
+}
+
+// paths returns the paths a file operation has to touch, including the source of a rename.
+func (f ChangedFile) paths() []string {
+	if f.OrigPath != "" {
+		return []string{f.Path, f.OrigPath}
+	}
+	return []string{f.Path}
+}
+
+// StageHunk adds a single hunk of an unstaged diff to the index.
+func (rm *RepoManager) StageHunk(path string, diff Diff, hunk int) error {
+	return rm.applyHunk(path, diff, hunk, "--cached")
+}
+
+// UnstageHunk removes a single hunk of a staged diff from the index.
+func (rm *RepoManager) UnstageHunk(path string, diff Diff, hunk int) error {
+	return rm.applyHunk(path, diff, hunk, "--cached", "--reverse")
+}
+
+// DiscardHunk reverts a single hunk of an unstaged diff in the working tree.
+func (rm *RepoManager) DiscardHunk(path string, diff Diff, hunk int) error {
+	return rm.applyHunk(path, diff, hunk, "--reverse")
+}
+
+// applyHunk writes the patch of a hunk to a temporary file and applies it with git apply.
+func (rm *RepoManager) applyHunk(path string, diff Diff, hunk int, args ...string) error {
+	patch, err := diff.HunkPatch(hunk)
+	if err != nil {
+		return err
+	}
+
+	patchFile, err := os.CreateTemp("", "git-dash-*.patch")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(patchFile.Name())
+
+	if _, err := patchFile.WriteString(patch); err != nil {
+		patchFile.Close()
+		return err
+	}
+	if err := patchFile.Close(); err != nil {
+		return err
+	}
+
+	_, err = rm.runGitCommand(path, append(append([]string{"apply"}, args...), patchFile.Name())...)
+	return err
+}
+
+// HunkPatch returns a patch containing only the given hunk, preceded by the header
+// of the file it belongs to.
+func (d Diff) HunkPatch(hunk int) (string, error) {
+	if hunk < 0 || hunk >= len(d.Hunks) {
+		return "", fmt.Errorf("no hunk %d in diff", hunk+1)
+	}
+	start := d.Hunks[hunk]
+
+	// The file header runs from the "diff" line to the first hunk of that file
+	headerStart := start
+	for headerStart > 0 && !strings.HasPrefix(d.Lines[headerStart].Text, "diff ") {
+		headerStart--
+	}
+	headerEnd := headerStart
+	for headerEnd < len(d.Lines) && d.Lines[headerEnd].Kind == DiffHeader {
+		headerEnd++
+	}
+
+	end := start + 1
+	for end < len(d.Lines) && d.Lines[end].Kind != DiffHunk && d.Lines[end].Kind != DiffHeader {
+		end++
+	}
+
+	var patch strings.Builder
+	for _, line := range d.Lines[headerStart:headerEnd] {
+		patch.WriteString(line.Text + "\n")
+	}
+	for _, line := range d.Lines[start:end] {
+		patch.WriteString(line.Text + "\n")
+	}
+	return patch.String(), nil
+}
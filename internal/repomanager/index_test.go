@@ -0,0 +1,148 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestFileIndexOperations(t *testing.T) {
+	isolateGit(t)
+
+	repo := filepath.Join(t.TempDir(), "repo")
+	git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "tracked.txt", "one\n")
+	commitFile(t, repo, "old.txt", "rename me\n")
+
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("tracked.txt", "two\n")
+	write("new.txt", "new\n")
+	git(t, repo, "mv", "old.txt", "renamed.txt")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	short := func() string { return git(t, repo, "status", "--short") }
+
+	if err := rm.StageFile(repo, ChangedFile{Path: "tracked.txt", Group: FileUnstaged}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.StageFile(repo, ChangedFile{Path: "new.txt", Group: FileUntracked}); err != nil {
+		t.Fatal(err)
+	}
+	if got := short(); got != "A  new.txt\nR  old.txt -> renamed.txt\nM  tracked.txt" {
+		t.Fatalf("after staging:\n%s", got)
+	}
+
+	if err := rm.UnstageFile(repo, ChangedFile{Path: "renamed.txt", OrigPath: "old.txt", Group: FileStaged}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.UnstageFile(repo, ChangedFile{Path: "tracked.txt", Group: FileStaged}); err != nil {
+		t.Fatal(err)
+	}
+	if got := short(); got != "A  new.txt\n D old.txt\n M tracked.txt\n?? renamed.txt" {
+		t.Fatalf("after unstaging:\n%s", got)
+	}
+
+	if err := rm.DiscardFile(repo, ChangedFile{Path: "tracked.txt", Group: FileUnstaged}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.DiscardFile(repo, ChangedFile{Path: "renamed.txt", Group: FileUntracked}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.DiscardFile(repo, ChangedFile{Path: "new.txt", Group: FileStaged}); err != nil {
+		t.Fatal(err)
+	}
+	// The output is trimmed, so the leading space of the status is gone
+	if got := short(); got != "D old.txt" {
+		t.Fatalf("after discarding:\n%s", got)
+	}
+	if _, err := os.Stat(filepath.Join(repo, "new.txt")); !os.IsNotExist(err) {
+		t.Error("expected the discarded new file to be deleted")
+	}
+}
+
+func TestUnstageFile_UnbornBranch(t *testing.T) {
+	isolateGit(t)
+
+	repo := filepath.Join(t.TempDir(), "repo")
+	git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	if err := os.WriteFile(filepath.Join(repo, "first.txt"), []byte("first\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, repo, "add", "first.txt")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	if err := rm.UnstageFile(repo, ChangedFile{Path: "first.txt", Group: FileStaged}); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, repo, "status", "--short"); got != "?? first.txt" {
+		t.Errorf("after unstaging: %q", got)
+	}
+}
+
+func TestHunkOperations(t *testing.T) {
+	isolateGit(t)
+
+	repo := filepath.Join(t.TempDir(), "repo")
+	git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	original := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+	commitFile(t, repo, "file.txt", original)
+	commitFile(t, repo, "other.txt", "other\n")
+
+	// Two separate hunks in file.txt, and one in a second file so the diff spans files
+	changed := "ONE\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\nTWELVE\n"
+	if err := os.WriteFile(filepath.Join(repo, "file.txt"), []byte(changed), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, "other.txt"), []byte("changed\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	readDiff := func(staged bool) Diff {
+		t.Helper()
+		diff, err := rm.ReadDiff(repo, DiffOptions{Staged: staged})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return diff
+	}
+
+	unstaged := readDiff(false)
+	if len(unstaged.Hunks) != 3 {
+		t.Fatalf("got %d hunks, want 3", len(unstaged.Hunks))
+	}
+
+	// Stage the second hunk of file.txt only
+	if err := rm.StageHunk(repo, unstaged, 1); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, repo, "show", ":file.txt"); got != "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\nTWELVE" {
+		t.Errorf("index after staging a hunk:\n%s", got)
+	}
+
+	if err := rm.UnstageHunk(repo, readDiff(true), 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, repo, "diff", "--cached", "--name-only"); got != "" {
+		t.Errorf("expected nothing staged, got %q", got)
+	}
+
+	// Discard the hunk in the second file, leaving file.txt alone
+	if err := rm.DiscardHunk(repo, readDiff(false), 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, repo, "status", "--short"); got != "M file.txt" {
+		t.Errorf("status after discarding a hunk: %q", got)
+	}
+
+	if _, err := unstaged.HunkPatch(5); err == nil {
+		t.Error("expected an error for a missing hunk")
+	}
+}
@@ -0,0 +1,178 @@
+package repomanager
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogEntry is a single line of a commit log graph. Lines that only continue the
+// graph between commits carry no commit information.
+type LogEntry struct {
+	Graph     string    // Graph drawing in front of the commit, or the whole line for graph-only lines
+	Hash      string    // Full commit hash, empty for graph-only lines
+	ShortHash string    // Abbreviated commit hash
+	Author    string    // Author name
+	Subject   string    // First line of the commit message
+	Time      time.Time // Author date
+	Unpushed  bool      // Whether the commit is not on the upstream branch yet
+}
+
+// IsCommit reports whether the entry describes a commit rather than a graph-only line.
+func (e LogEntry) IsCommit() bool {
+	return e.Hash != ""
+}
+
+// LogPage is the beginning of the history of a repository.
+type LogPage struct {
+	Entries []LogEntry
+	HasMore bool // Whether older commits exist beyond the loaded ones
+}
+
+// FileStat counts the lines a commit changed in a single file.
+type FileStat struct {
+	Path    string
+	Added   int
+	Deleted int
+	Binary  bool
+}
+
+// CommitDetail is the full message and per-file statistics of a commit.
+type CommitDetail struct {
+	Hash    string
+	Author  string
+	Email   string
+	Time    time.Time
+	Message string
+	Files   []FileStat
+}
+
+// logFormat marks the start of every commit with a record separator so it can be
+// told apart from the graph drawing in front of it.
+const logFormat = "--format=%x1e%H%x1f%h%x1f%an%x1f%at%x1f%s"
+
+// detailFormat prints the commit header followed by a record separator and the numstat lines.
+const detailFormat = "--format=%H%x1f%an%x1f%ae%x1f%at%x1f%B%x1e"
+
+// ReadLog reads the history of HEAD as a graph, up to limit commits. Loading more
+// history means reading again with a higher limit, which keeps the graph intact.
+func (rm *RepoManager) ReadLog(path string, limit int) (LogPage, error) {
+	output, err := rm.runGitCommand(path, "log", "--graph", "--no-color", logFormat, "-n", strconv.Itoa(limit+1), "HEAD")
+	if err != nil {
+		return LogPage{}, err
+	}
+
+	page := parseLog(string(output), limit)
+
+	unpushed := rm.readUnpushed(path, limit)
+	for i := range page.Entries {
+		page.Entries[i].Unpushed = unpushed[page.Entries[i].Hash]
+	}
+	return page, nil
+}
+
+// parseLog parses git log --graph output printed with logFormat and keeps at most
+// limit commits, reporting whether more were available.
+func parseLog(output string, limit int) LogPage {
+	var page LogPage
+	commits := 0
+
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+
+		graph, record, isCommit := strings.Cut(line, "\x1e")
+		if !isCommit {
+			page.Entries = append(page.Entries, LogEntry{Graph: line})
+			continue
+		}
+
+		if commits == limit {
+			page.HasMore = true
+			break
+		}
+		commits++
+
+		entry := LogEntry{Graph: graph}
+		fields := strings.SplitN(record, "\x1f", 5)
+		if len(fields) == 5 {
+			entry.Hash = fields[0]
+			entry.ShortHash = fields[1]
+			entry.Author = fields[2]
+			entry.Subject = fields[4]
+			if seconds, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+				entry.Time = time.Unix(seconds, 0)
+			}
+		}
+		page.Entries = append(page.Entries, entry)
+	}
+
+	// Graph lines after the last loaded commit belong to commits that were cut off
+	for len(page.Entries) > 0 && !page.Entries[len(page.Entries)-1].IsCommit() && page.HasMore {
+		page.Entries = page.Entries[:len(page.Entries)-1]
+	}
+	return page
+}
+
+// readUnpushed returns the hashes of commits on HEAD that are not on the upstream
+// branch. Without an upstream, commits not on any remote-tracking branch count as unpushed.
+func (rm *RepoManager) readUnpushed(path string, limit int) map[string]bool {
+	maxCount := "--max-count=" + strconv.Itoa(limit)
+
+	output, err := rm.runGitCommand(path, "rev-list", maxCount, "@{upstream}..HEAD")
+	if err != nil {
+		if output, err = rm.runGitCommand(path, "rev-list", maxCount, "HEAD", "--not", "--remotes"); err != nil {
+			return nil
+		}
+	}
+
+	unpushed := make(map[string]bool)
+	for _, hash := range strings.Fields(string(output)) {
+		unpushed[hash] = true
+	}
+	return unpushed
+}
+
+// ReadCommitDetail reads the full message and changed-file statistics of a commit.
+func (rm *RepoManager) ReadCommitDetail(path, hash string) (CommitDetail, error) {
+	output, err := rm.runGitCommand(path, "show", "--numstat", "--no-color", detailFormat, hash)
+	if err != nil {
+		return CommitDetail{}, err
+	}
+	return parseCommitDetail(string(output)), nil
+}
+
+// parseCommitDetail parses git show --numstat output printed with detailFormat.
+func parseCommitDetail(output string) CommitDetail {
+	var detail CommitDetail
+
+	header, numstat, _ := strings.Cut(output, "\x1e")
+	fields := strings.SplitN(header, "\x1f", 5)
+	if len(fields) == 5 {
+		detail.Hash = fields[0]
+		detail.Author = fields[1]
+		detail.Email = fields[2]
+		detail.Message = strings.TrimRight(fields[4], "\n")
+		if seconds, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+			detail.Time = time.Unix(seconds, 0)
+		}
+	}
+
+	for _, line := range strings.Split(numstat, "\n") {
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+
+		stat := FileStat{Path: parts[2]}
+		if parts[0] == "-" && parts[1] == "-" {
+			stat.Binary = true
+		} else {
+			stat.Added, _ = strconv.Atoi(parts[0])
+			stat.Deleted, _ = strconv.Atoi(parts[1])
+		}
+		detail.Files = append(detail.Files, stat)
+	}
+	return detail
+}
@@ -0,0 +1,119 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestReadLog(t *testing.T) {
+	isolateGit(t)
+
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+	clone := filepath.Join(root, "clone")
+	git(t, root, "clone", "-q", remote, clone)
+
+	// A merged side branch gives the graph a fork, the last two commits are unpushed
+	git(t, clone, "checkout", "-q", "-b", "side")
+	commitFile(t, clone, "side.txt", "side\n")
+	git(t, clone, "checkout", "-q", "main")
+	commitFile(t, clone, "main.txt", "main\n")
+	git(t, clone, "merge", "-q", "--no-edit", "side")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{clone}})
+
+	page, err := rm.ReadLog(clone, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.HasMore {
+		t.Error("expected the whole history to fit")
+	}
+
+	var commits []LogEntry
+	graphOnly := 0
+	for _, entry := range page.Entries {
+		if entry.IsCommit() {
+			commits = append(commits, entry)
+		} else {
+			graphOnly++
+		}
+	}
+	if len(commits) != 4 {
+		t.Fatalf("got %d commits, want 4", len(commits))
+	}
+	if graphOnly == 0 {
+		t.Error("expected graph-only lines around the merge")
+	}
+
+	merge, seed := commits[0], commits[len(commits)-1]
+	if !strings.HasPrefix(merge.Subject, "Merge branch 'side'") || merge.Author != "Test" || merge.Time.IsZero() || !strings.HasPrefix(merge.Graph, "*") {
+		t.Errorf("unexpected merge entry %+v", merge)
+	}
+	if !merge.Unpushed || seed.Unpushed {
+		t.Errorf("expected only local commits to be unpushed: merge=%v seed=%v", merge.Unpushed, seed.Unpushed)
+	}
+	for _, commit := range commits[:3] {
+		if !commit.Unpushed {
+			t.Errorf("expected %q to be unpushed", commit.Subject)
+		}
+	}
+
+	// Paging cuts the log after the requested number of commits
+	page, err = rm.ReadLog(clone, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for _, entry := range page.Entries {
+		if entry.IsCommit() {
+			count++
+		}
+	}
+	if count != 2 || !page.HasMore || !page.Entries[len(page.Entries)-1].IsCommit() {
+		t.Errorf("unexpected first page: %d commits, HasMore=%v", count, page.HasMore)
+	}
+}
+
+func TestReadCommitDetail(t *testing.T) {
+	isolateGit(t)
+
+	repo := filepath.Join(t.TempDir(), "repo")
+	git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "notes.txt", "one\ntwo\n")
+
+	if err := os.WriteFile(filepath.Join(repo, "notes.txt"), []byte("one\nthree\nfour\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, "image.bin"), []byte{0, 1, 2, 0}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, repo, "add", ".")
+	git(t, repo, "commit", "-q", "-m", "Update notes", "-m", "Longer explanation.")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	detail, err := rm.ReadCommitDetail(repo, "HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if detail.Message != "Update notes\n\nLonger explanation." || detail.Email != "test@example.com" || len(detail.Hash) != 40 {
+		t.Errorf("unexpected commit header %+v", detail)
+	}
+	want := []FileStat{
+		{Path: "image.bin", Binary: true},
+		{Path: "notes.txt", Added: 2, Deleted: 1},
+	}
+	if len(detail.Files) != len(want) {
+		t.Fatalf("got files %+v, want %+v", detail.Files, want)
+	}
+	for i := range want {
+		if detail.Files[i] != want[i] {
+			t.Errorf("file %d = %+v, want %+v", i, detail.Files[i], want[i])
+		}
+	}
+}
@@ -2,10 +2,12 @@
 package repomanager
 
 import (
-	"os"
-	"os/exec"
+	"context"
+	"fmt"
 	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/jarmocluyse/git-dash/internal/config"
 )
@@ -13,14 +15,22 @@ import (
 // RepoManager manages repositories and their worktrees.
 type RepoManager struct {
 	configService config.ConfigService
+	backend       GitBackend
 	items         []*RepoItem
+	concurrency   int                  // Maximum number of items refreshed in parallel
+	fetchConfig   config.FetchConfig   // Background fetch settings
+	pullStrategy  string               // How pulls integrate the upstream branch
+	cleanup       config.CleanupConfig // Which branches the cleanup report lists
+	mu            sync.RWMutex         // Guards items and the status fields written by workers
 }
 
-// NewRepoManager creates a new repository manager.
-func NewRepoManager(configService config.ConfigService) *RepoManager {
+// NewRepoManager creates a new repository manager that runs git through the given backend.
+func NewRepoManager(configService config.ConfigService, backend GitBackend) *RepoManager {
 	return &RepoManager{
 		configService: configService,
+		backend:       backend,
 		items:         make([]*RepoItem, 0),
+		concurrency:   defaultConcurrency,
 	}
 }
 
@@ -31,63 +41,103 @@ func (rm *RepoManager) Init() error {
 		return err
 	}
 
-	// Clear existing items
-	rm.items = make([]*RepoItem, 0, len(config.RepositoryPaths))
+	if config.StatusConcurrency > 0 {
+		rm.concurrency = config.StatusConcurrency
+	}
+	rm.fetchConfig = config.Fetch
+	rm.pullStrategy = config.PullStrategy
+	rm.cleanup = config.Cleanup
 
 	// Load repositories from config paths
+	items := make([]*RepoItem, 0, len(config.RepositoryPaths))
 	for _, path := range config.RepositoryPaths {
-		item := &RepoItem{
-			Name:     extractNameFromPath(path),
-			Path:     path,
-			SubItems: make([]*SubItem, 0),
-		}
+		items = append(items, newRepoItem(path))
+	}
 
-		// Update status for this repository
-		rm.updateRepoStatus(item)
+	// Publish the items first: loading worktrees leaves out those tracked on their own
+	rm.mu.Lock()
+	rm.items = items
+	rm.mu.Unlock()
 
-		// Load worktrees if this is a bare repository
-		if item.IsBare {
-			rm.loadWorktrees(item)
-		}
-
-		rm.items = append(rm.items, item)
-	}
+	// Update status and load worktrees for all repositories in parallel
+	runBounded(len(items), rm.concurrency, func(i int) {
+		rm.updateRepoStatus(items[i])
+		rm.loadLastFetch(items[i])
+		rm.loadWorktrees(items[i])
+	})
 
 	return nil
 }
 
-// GetItems returns all repository items.
+// newRepoItem creates the item of a tracked repository. Its canonical path is
+// resolved once here, so comparing against listed worktrees needs no file system calls.
+func newRepoItem(path string) *RepoItem {
+	return &RepoItem{
+		Name:      extractNameFromPath(path),
+		Path:      path,
+		SubItems:  make([]*SubItem, 0),
+		canonical: canonicalPath(path),
+	}
+}
+
+// GetItems returns a snapshot of all repository items. The items are copies taken
+// under the lock, so they can be read while workers refresh the originals; later
+// refreshes show up in the next call.
 func (rm *RepoManager) GetItems() []*RepoItem {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	items := make([]*RepoItem, len(rm.items))
+	for i, item := range rm.items {
+		items[i] = item.snapshot()
+	}
+	return items
+}
+
+// sharedItems returns the items the manager refreshes in place. Their status
+// fields must only be accessed under rm.mu.
+func (rm *RepoManager) sharedItems() []*RepoItem {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
 	items := make([]*RepoItem, len(rm.items))
 	copy(items, rm.items)
 	return items
 }
 
+// snapshot copies the item and its worktrees. The caller must hold rm.mu.
+func (item *RepoItem) snapshot() *RepoItem {
+	copied := *item
+	copied.SubItems = make([]*SubItem, len(item.SubItems))
+	for i, subItem := range item.SubItems {
+		copiedSub := *subItem
+		copiedSub.ParentRepo = &copied
+		copied.SubItems[i] = &copiedSub
+	}
+	return &copied
+}
+
 // AddRepo adds a new repository by path.
 func (rm *RepoManager) AddRepo(path string) error {
 	// Check if already exists
-	for _, item := range rm.items {
+	for _, item := range rm.sharedItems() {
 		if item.Path == path {
 			return nil // Already exists
 		}
 	}
 
 	// Create new repo item
-	item := &RepoItem{
-		Name:     extractNameFromPath(path),
-		Path:     path,
-		SubItems: make([]*SubItem, 0),
-	}
+	item := newRepoItem(path)
 
 	// Update status
 	rm.updateRepoStatus(item)
+	rm.loadLastFetch(item)
 
-	// Load worktrees if bare
-	if item.IsBare {
-		rm.loadWorktrees(item)
-	}
-
+	rm.mu.Lock()
 	rm.items = append(rm.items, item)
+	rm.mu.Unlock()
+
+	rm.loadWorktrees(item)
 
 	// Update config
 	config, err := rm.configService.Load()
@@ -102,12 +152,14 @@ func (rm *RepoManager) AddRepo(path string) error {
 // RemoveRepo removes a repository by path.
 func (rm *RepoManager) RemoveRepo(path string) error {
 	// Find and remove the item
+	rm.mu.Lock()
 	for i, item := range rm.items {
 		if item.Path == path {
 			rm.items = append(rm.items[:i], rm.items[i+1:]...)
 			break
 		}
 	}
+	rm.mu.Unlock()
 
 	// Update config
 	config, err := rm.configService.Load()
@@ -119,32 +171,111 @@ func (rm *RepoManager) RemoveRepo(path string) error {
 	return rm.configService.Save(config)
 }
 
-// ReloadWorktrees reloads worktrees for all bare repositories.
+// ReloadWorktrees reloads the worktrees of all repositories.
 func (rm *RepoManager) ReloadWorktrees() error {
-	for _, item := range rm.items {
-		if item.IsBare {
+	items := rm.sharedItems()
+	runBounded(len(items), rm.concurrency, func(i int) {
+		rm.loadWorktrees(items[i])
+	})
+	return nil
+}
+
+// RefreshItem re-reads the status of the repository or worktree at path.
+// Repositories also reload their worktree list. It reports whether an item was found.
+func (rm *RepoManager) RefreshItem(path string) bool {
+	for _, item := range rm.sharedItems() {
+		if item.Path == path {
+			rm.updateRepoStatus(item)
 			rm.loadWorktrees(item)
+			return true
+		}
+
+		rm.mu.RLock()
+		var match *SubItem
+		for _, subItem := range item.SubItems {
+			if subItem.Path == path {
+				match = subItem
+				break
+			}
+		}
+		rm.mu.RUnlock()
+
+		if match != nil {
+			rm.updateSubItemStatus(match)
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
 // ReloadStatus reloads status for all repositories and their worktrees.
 func (rm *RepoManager) ReloadStatus() error {
+	return rm.ReloadStatusWithProgress(nil)
+}
+
+// ReloadStatusWithProgress reloads status for all repositories and their worktrees
+// using a bounded worker pool. The progress callback, when not nil, is invoked once
+// per refreshed item and may be called from multiple goroutines at the same time.
+func (rm *RepoManager) ReloadStatusWithProgress(progress func(StatusUpdate)) error {
+	targets := rm.statusTargets()
+	total := len(targets)
+
+	var completed atomic.Int64
+	runBounded(total, rm.concurrency, func(i int) {
+		targets[i].refresh()
+
+		done := int(completed.Add(1))
+		if progress != nil {
+			progress(StatusUpdate{
+				Path:      targets[i].path,
+				Completed: done,
+				Total:     total,
+			})
+		}
+	})
+	return nil
+}
+
+// statusTarget is a single unit of work for a status refresh.
+type statusTarget struct {
+	path    string
+	refresh func()
+}
+
+// statusTargets returns a refresh target for every repository and worktree.
+// Repositories reload their worktree list as well, so worktrees added or removed
+// outside the dashboard show up without reading them on the UI goroutine.
+func (rm *RepoManager) statusTargets() []statusTarget {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	var targets []statusTarget
 	for _, item := range rm.items {
-		rm.updateRepoStatus(item)
+		targets = append(targets, statusTarget{
+			path: item.Path,
+			refresh: func() {
+				rm.updateRepoStatus(item)
+				rm.loadWorktrees(item)
+			},
+		})
 
 		// Update status for all worktrees
 		for _, subItem := range item.SubItems {
-			rm.updateSubItemStatus(subItem)
+			targets = append(targets, statusTarget{
+				path:    subItem.Path,
+				refresh: func() { rm.updateSubItemStatus(subItem) },
+			})
 		}
 	}
-	return nil
+	return targets
 }
 
 // GetSummary calculates and returns summary data for all repositories and worktrees.
 func (rm *RepoManager) GetSummary() SummaryData {
-	var data SummaryData
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	data := SummaryData{InProgress: make(map[Operation]int)}
 
 	for _, item := range rm.items {
 		if item.HasUncommitted {
@@ -153,12 +284,24 @@ func (rm *RepoManager) GetSummary() SummaryData {
 		if item.HasUnpushed {
 			data.TotalUnpushed += item.UnpushedCount
 		}
+		if item.HasBehind {
+			data.TotalBehind += item.BehindCount
+		}
 		if item.HasUntracked {
 			data.TotalUntracked += item.UntrackedCount
 		}
 		if item.HasError {
 			data.TotalErrors++
 		}
+		if item.Operation.InProgress() {
+			data.InProgress[item.Operation]++
+		}
+		if item.FetchStale {
+			data.TotalStale++
+		}
+
+		// Worktrees share their repository's stash list, so only count it once
+		data.TotalStashes += item.StashCount
 
 		// Add sub-items (worktrees)
 		for _, subItem := range item.SubItems {
@@ -168,57 +311,129 @@ func (rm *RepoManager) GetSummary() SummaryData {
 			if subItem.HasUnpushed {
 				data.TotalUnpushed += subItem.UnpushedCount
 			}
+			if subItem.HasBehind {
+				data.TotalBehind += subItem.BehindCount
+			}
 			if subItem.HasUntracked {
 				data.TotalUntracked += subItem.UntrackedCount
 			}
 			if subItem.HasError {
 				data.TotalErrors++
 			}
+			if subItem.Operation.InProgress() {
+				data.InProgress[subItem.Operation]++
+			}
 		}
 	}
 
 	return data
 }
 
+// applyStatus copies a parsed git status onto the repository item.
+func (item *RepoItem) applyStatus(status GitStatus) {
+	item.Branch = status.Branch
+	item.Upstream = status.Upstream
+	item.Detached = status.Detached
+	item.HeadCommit = status.ShortHead()
+	item.HasUncommitted = status.Changed > 0
+	item.HasUnpushed = status.Ahead > 0
+	item.HasBehind = status.Behind > 0
+	item.HasUntracked = status.Untracked > 0
+	item.UncommittedCount = status.Changed
+	item.UnpushedCount = status.Ahead
+	item.BehindCount = status.Behind
+	item.UntrackedCount = status.Untracked
+	item.StagedCount = status.Staged
+	item.UnstagedCount = status.Unstaged
+	item.ConflictedCount = status.Conflicted
+	item.RenamedCount = status.Renamed
+}
+
+// applyStatus copies a parsed git status onto the worktree sub-item.
+func (subItem *SubItem) applyStatus(status GitStatus) {
+	// Keep the branch reported by git worktree list when the status could not be read
+	if status.Head != "" {
+		subItem.Branch = status.Branch
+	}
+	subItem.Upstream = status.Upstream
+	subItem.Detached = status.Detached
+	subItem.HeadCommit = status.ShortHead()
+	subItem.HasUncommitted = status.Changed > 0
+	subItem.HasUnpushed = status.Ahead > 0
+	subItem.HasBehind = status.Behind > 0
+	subItem.HasUntracked = status.Untracked > 0
+	subItem.UncommittedCount = status.Changed
+	subItem.UnpushedCount = status.Ahead
+	subItem.BehindCount = status.Behind
+	subItem.UntrackedCount = status.Untracked
+	subItem.StagedCount = status.Staged
+	subItem.UnstagedCount = status.Unstaged
+	subItem.ConflictedCount = status.Conflicted
+	subItem.RenamedCount = status.Renamed
+}
+
 // updateRepoStatus updates the status of a repository item.
 func (rm *RepoManager) updateRepoStatus(item *RepoItem) {
-	if !rm.isGitRepository(item.Path) {
-		item.HasError = true
-		item.HasUncommitted = false
-		item.HasUnpushed = false
-		item.HasUntracked = false
-		item.UncommittedCount = 0
-		item.UnpushedCount = 0
-		item.UntrackedCount = 0
+	info, err := rm.readRepoInfo(item.Path)
+	if err != nil {
+		rm.setRepoError(item)
 		return
 	}
 
-	item.IsBare = rm.isBareRepository(item.Path)
-	item.IsWorktree = rm.isWorktree(item.Path)
-	item.HasError = false
+	stashCount := readStashCount(info.commonDir)
 
-	if item.IsBare {
-		// For bare repositories, no status information is relevant
-		item.HasUncommitted = false
-		item.HasUnpushed = false
-		item.HasUntracked = false
-		item.UncommittedCount = 0
-		item.UnpushedCount = 0
-		item.UntrackedCount = 0
-	} else {
-		// For regular repositories, check normal git status
-		item.HasUncommitted = rm.hasUncommittedChanges(item.Path)
-		item.HasUnpushed = rm.hasUnpushedCommits(item.Path)
-		item.HasUntracked = rm.hasUntrackedFiles(item.Path)
-		item.UncommittedCount = rm.countUncommittedChanges(item.Path)
-		item.UnpushedCount = rm.countUnpushedCommits(item.Path)
-		item.UntrackedCount = rm.countUntrackedFiles(item.Path)
+	// For bare repositories, no status information is relevant
+	var status GitStatus
+	var operation Operation
+	if !info.bare {
+		operation = readOperation(info.gitDir)
+		status, err = rm.readStatus(item.Path)
+		if err != nil {
+			rm.setRepoError(item)
+			return
+		}
 	}
+
+	rm.mu.RLock()
+	cachedOid, cachedCommit := item.commitOid, item.LastCommit
+	rm.mu.RUnlock()
+	lastCommit, commitOid := rm.lastCommitFor(item.Path, status.Head, cachedOid, cachedCommit)
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	item.IsBare = info.bare
+	item.IsWorktree = info.worktree
+	item.gitDir = info.gitDir
+	item.commonDir = info.commonDir
+	item.HasError = false
+	item.applyStatus(status)
+	item.StashCount = stashCount
+	item.Operation = operation
+	item.LastCommit = lastCommit
+	item.commitOid = commitOid
 }
 
-// loadWorktrees loads worktrees for a bare repository.
+// setRepoError marks a repository item as inaccessible and clears its status.
+func (rm *RepoManager) setRepoError(item *RepoItem) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	item.HasError = true
+	item.applyStatus(GitStatus{})
+	item.StashCount = 0
+	item.Operation = OperationNone
+	item.LastCommit = CommitInfo{}
+	item.commitOid = ""
+}
+
+// loadWorktrees loads the linked worktrees of a repository. A tracked linked
+// worktree has none of its own; the repository it belongs to lists them.
 func (rm *RepoManager) loadWorktrees(item *RepoItem) {
-	if !item.IsBare {
+	rm.mu.RLock()
+	linked := item.IsWorktree
+	rm.mu.RUnlock()
+	if linked {
 		return
 	}
 
@@ -226,14 +441,30 @@ func (rm *RepoManager) loadWorktrees(item *RepoItem) {
 	if err != nil {
 		return
 	}
+	tracked := rm.trackedPaths()
+
+	// Reuse existing sub-items so their cached status survives a reload
+	rm.mu.RLock()
+	existing := make(map[string]*SubItem, len(item.SubItems))
+	for _, subItem := range item.SubItems {
+		existing[subItem.Path] = subItem
+	}
+	rm.mu.RUnlock()
 
-	// Clear existing sub-items
-	item.SubItems = make([]*SubItem, 0, len(worktrees))
+	subItems := make([]*SubItem, 0, len(worktrees))
+	infos := make(map[*SubItem]WorktreeInfo, len(worktrees))
 
 	// Create sub-items for each worktree, excluding the main repository itself
 	for _, wt := range worktrees {
-		// Skip the main repository - it should not be listed as its own worktree
-		if wt.Path == item.Path {
+		// Skip the main repository - it should not be listed as its own worktree -
+		// and worktrees tracked as repositories of their own, so none shows twice
+		if wt.Path == item.Path || tracked[canonicalPath(wt.Path)] {
+			continue
+		}
+
+		if subItem, ok := existing[wt.Path]; ok {
+			infos[subItem] = wt
+			subItems = append(subItems, subItem)
 			continue
 		}
 
@@ -241,87 +472,97 @@ func (rm *RepoManager) loadWorktrees(item *RepoItem) {
 			Name:       extractNameFromPath(wt.Path),
 			Path:       wt.Path,
 			Branch:     wt.Branch,
+			Detached:   wt.Detached,
 			ParentRepo: item,
 		}
+		subItem.applyWorktreeInfo(wt)
 
 		// Update status for this worktree
 		rm.updateSubItemStatus(subItem)
 
-		item.SubItems = append(item.SubItems, subItem)
+		subItems = append(subItems, subItem)
+	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	for subItem, wt := range infos {
+		subItem.Branch = wt.Branch
+		subItem.applyWorktreeInfo(wt)
 	}
+	item.SubItems = subItems
 }
 
 // updateSubItemStatus updates the status of a worktree sub-item.
 func (rm *RepoManager) updateSubItemStatus(subItem *SubItem) {
-	if !rm.isGitRepository(subItem.Path) {
-		subItem.HasError = true
-		subItem.HasUncommitted = false
-		subItem.HasUnpushed = false
-		subItem.HasUntracked = false
-		subItem.UncommittedCount = 0
-		subItem.UnpushedCount = 0
-		subItem.UntrackedCount = 0
-		return
-	}
-
-	subItem.HasError = false
-	subItem.HasUncommitted = rm.hasUncommittedChanges(subItem.Path)
-	subItem.HasUnpushed = rm.hasUnpushedCommits(subItem.Path)
-	subItem.HasUntracked = rm.hasUntrackedFiles(subItem.Path)
-	subItem.UncommittedCount = rm.countUncommittedChanges(subItem.Path)
-	subItem.UnpushedCount = rm.countUnpushedCommits(subItem.Path)
-	subItem.UntrackedCount = rm.countUntrackedFiles(subItem.Path)
+	status, err := rm.readStatus(subItem.Path)
+
+	// Worktrees share the stash list of the repository they belong to
+	rm.mu.RLock()
+	commonDir := subItem.ParentRepo.commonDir
+	cachedOid, cachedCommit := subItem.commitOid, subItem.LastCommit
+	rm.mu.RUnlock()
+	stashCount := readStashCount(commonDir)
+	operation := readOperation(linkedGitDir(subItem.Path))
+
+	var lastCommit CommitInfo
+	var commitOid string
+	if err == nil {
+		lastCommit, commitOid = rm.lastCommitFor(subItem.Path, status.Head, cachedOid, cachedCommit)
+	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	subItem.HasError = err != nil
+	subItem.applyStatus(status)
+	subItem.StashCount = stashCount
+	subItem.Operation = operation
+	subItem.LastCommit = lastCommit
+	subItem.commitOid = commitOid
 }
 
 // Git command methods
 
-// isGitRepository checks if the given path contains a Git repository.
-func (rm *RepoManager) isGitRepository(path string) bool {
-	if rm.hasGitDirectory(path) {
-		return true
-	}
-	if rm.isBareRepository(path) {
-		return true
-	}
-	return rm.canRunGitCommand(path)
+// repoInfo describes the layout of the repository at a path.
+type repoInfo struct {
+	bare      bool   // Whether the repository is bare
+	worktree  bool   // Whether the path is a linked worktree
+	gitDir    string // Absolute path of the git directory
+	commonDir string // Absolute path of the directory shared by all worktrees
 }
 
-// isBareRepository checks if the repository at the given path is a bare repository.
-func (rm *RepoManager) isBareRepository(path string) bool {
-	output, err := rm.runGitCommand(path, "rev-parse", "--is-bare-repository")
+// readRepoInfo determines the repository layout with a single rev-parse call.
+// An error means the path is not inside a Git repository.
+func (rm *RepoManager) readRepoInfo(path string) (repoInfo, error) {
+	output, err := rm.runGitCommand(path, "rev-parse", "--is-bare-repository", "--is-inside-work-tree", "--git-dir", "--git-common-dir")
 	if err != nil {
-		return false
+		return repoInfo{}, err
 	}
-	return strings.TrimSpace(string(output)) == "true"
-}
 
-// hasGitDirectory checks if the path contains a .git directory or file.
-func (rm *RepoManager) hasGitDirectory(path string) bool {
-	gitPath := filepath.Join(path, ".git")
-	info, err := os.Stat(gitPath)
-	if err != nil {
-		return false
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) < 4 {
+		return repoInfo{}, fmt.Errorf("unexpected rev-parse output: %q", output)
 	}
-	return info.IsDir() || rm.isWorktreeGitFile(gitPath)
-}
 
-// isWorktreeGitFile checks if the .git file is a worktree reference.
-func (rm *RepoManager) isWorktreeGitFile(gitPath string) bool {
-	info, err := os.Stat(gitPath)
-	if err != nil {
-		return false
-	}
+	// A linked worktree has its own git dir inside the common dir of the main repository
+	gitDir := resolveGitPath(path, lines[2])
+	commonDir := resolveGitPath(path, lines[3])
 
-	if info.IsDir() {
-		return false
-	}
+	return repoInfo{
+		bare:      lines[0] == "true",
+		worktree:  lines[1] == "true" && gitDir != commonDir,
+		gitDir:    gitDir,
+		commonDir: commonDir,
+	}, nil
+}
 
-	content, err := os.ReadFile(gitPath)
-	if err != nil {
-		return false
+// resolveGitPath makes a path printed by rev-parse absolute relative to the repository path.
+func resolveGitPath(repoPath, gitPath string) string {
+	if !filepath.IsAbs(gitPath) {
+		gitPath = filepath.Join(repoPath, gitPath)
 	}
-
-	return strings.HasPrefix(string(content), "gitdir:")
+	return filepath.Clean(gitPath)
 }
 
 // listWorktrees returns all worktrees for the repository at the given path.
@@ -351,13 +592,8 @@ func (rm *RepoManager) parseWorktreeList(output string) ([]WorktreeInfo, error)
 			continue
 		}
 
-		parts := strings.SplitN(line, " ", 2)
-		if len(parts) < 2 {
-			continue
-		}
-
-		key := parts[0]
-		value := parts[1]
+		// Attribute lines such as "bare" or "locked" may come without a value
+		key, value, _ := strings.Cut(line, " ")
 
 		switch key {
 		case "worktree":
@@ -366,6 +602,14 @@ func (rm *RepoManager) parseWorktreeList(output string) ([]WorktreeInfo, error)
 			current.Branch = strings.TrimPrefix(value, "refs/heads/")
 		case "bare":
 			current.Bare = true
+		case "detached":
+			current.Detached = true
+		case "locked":
+			current.Locked = true
+			current.LockReason = value
+		case "prunable":
+			current.Prunable = true
+			current.PruneReason = value
 		}
 	}
 
@@ -376,158 +620,14 @@ func (rm *RepoManager) parseWorktreeList(output string) ([]WorktreeInfo, error)
 	return worktrees, nil
 }
 
-// isWorktree checks if the given path is a Git worktree.
-func (rm *RepoManager) isWorktree(path string) bool {
-	output, err := rm.runGitCommand(path, "rev-parse", "--is-inside-work-tree")
-	if err != nil {
-		return false
-	}
-
-	isInsideWorkTree := strings.TrimSpace(string(output)) == "true"
-	if !isInsideWorkTree {
-		return false
-	}
-
-	output, err = rm.runGitCommand(path, "rev-parse", "--git-common-dir")
-	if err != nil {
-		return false
-	}
-
-	commonDir := strings.TrimSpace(string(output))
-	output, err = rm.runGitCommand(path, "rev-parse", "--git-dir")
-	if err != nil {
-		return false
-	}
-
-	gitDir := strings.TrimSpace(string(output))
-	return commonDir != gitDir
-}
-
-// canRunGitCommand checks if git commands can be run in the given path.
-func (rm *RepoManager) canRunGitCommand(path string) bool {
-	cmd := exec.Command("git", "rev-parse", "--git-dir")
-	cmd.Dir = path
-	return cmd.Run() == nil
-}
-
-// hasUncommittedChanges checks if the repository has uncommitted changes.
-func (rm *RepoManager) hasUncommittedChanges(path string) bool {
-	output, err := rm.runGitCommand(path, "status", "--porcelain")
-	if err != nil {
-		return false
-	}
-	return rm.hasOutput(output)
-}
-
-// hasUnpushedCommits checks if the repository has unpushed commits.
-func (rm *RepoManager) hasUnpushedCommits(path string) bool {
-	if rm.branchIsAhead(path) {
-		return true
-	}
-	return rm.hasCommitsAheadOfUpstream(path)
-}
-
-// branchIsAhead checks if the current branch is ahead of its upstream.
-func (rm *RepoManager) branchIsAhead(path string) bool {
-	output, err := rm.runGitCommand(path, "status", "--porcelain=v1", "--branch")
-	if err != nil {
-		return false
-	}
-
-	lines := strings.Split(string(output), "\n")
-	if len(lines) == 0 {
-		return false
-	}
-
-	return strings.Contains(lines[0], "[ahead")
-}
-
-// hasCommitsAheadOfUpstream checks for commits ahead of upstream using log.
-func (rm *RepoManager) hasCommitsAheadOfUpstream(path string) bool {
-	output, err := rm.runGitCommand(path, "log", "--oneline", "@{u}..")
-	if err != nil {
-		return false
-	}
-	return rm.hasOutput(output)
-}
-
-// hasUntrackedFiles checks if the repository has untracked files.
-func (rm *RepoManager) hasUntrackedFiles(path string) bool {
-	output, err := rm.runGitCommand(path, "status", "--porcelain")
-	if err != nil {
-		return false
-	}
-
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	for _, line := range lines {
-		if strings.HasPrefix(line, "??") {
-			return true
-		}
-	}
-	return false
-}
-
-// countUncommittedChanges returns the number of files with uncommitted changes (tracked files only).
-func (rm *RepoManager) countUncommittedChanges(path string) int {
-	output, err := rm.runGitCommand(path, "status", "--porcelain")
-	if err != nil {
-		return 0
-	}
-
-	count := 0
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	for _, line := range lines {
-		// Skip untracked files (lines starting with ??)
-		// Count modified files (M), added files (A), deleted files (D), renamed files (R), etc.
-		if len(line) >= 2 && !strings.HasPrefix(line, "??") && strings.TrimSpace(line) != "" {
-			count++
-		}
-	}
-	return count
-}
-
-// countUnpushedCommits returns the number of unpushed commits.
-func (rm *RepoManager) countUnpushedCommits(path string) int {
-	// First try counting with log command
-	output, err := rm.runGitCommand(path, "log", "--oneline", "@{u}..")
-	if err != nil {
-		return 0
-	}
-
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-	if len(lines) == 1 && lines[0] == "" {
-		return 0
-	}
-	return len(lines)
-}
-
-// countUntrackedFiles returns the number of untracked files.
-func (rm *RepoManager) countUntrackedFiles(path string) int {
-	output, err := rm.runGitCommand(path, "status", "--porcelain")
-	if err != nil {
-		return 0
-	}
-
-	count := 0
-	lines := strings.SplitSeq(strings.TrimSpace(string(output)), "\n")
-	for line := range lines {
-		if strings.HasPrefix(line, "??") {
-			count++
-		}
-	}
-	return count
-}
-
 // runGitCommand executes a git command in the specified directory.
 func (rm *RepoManager) runGitCommand(path string, args ...string) ([]byte, error) {
-	cmd := exec.Command("git", args...)
-	cmd.Dir = path
-	return cmd.Output()
+	return rm.backend.Run(context.Background(), path, args...)
 }
 
-// hasOutput checks if the command output is non-empty.
-func (rm *RepoManager) hasOutput(output []byte) bool {
-	return len(strings.TrimSpace(string(output))) > 0
+// runGitCommandContext executes a git command that is cancelled together with ctx.
+func (rm *RepoManager) runGitCommandContext(ctx context.Context, path string, args ...string) ([]byte, error) {
+	return rm.backend.Run(ctx, path, args...)
 }
 
 // extractNameFromPath extracts a name from a file path.
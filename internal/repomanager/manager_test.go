@@ -0,0 +1,215 @@
+package repomanager
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+var (
+	revParseArgs = []string{"rev-parse", "--is-bare-repository", "--is-inside-work-tree", "--git-dir", "--git-common-dir"}
+	statusArgs   = []string{"status", "--porcelain=v2", "--branch"}
+	worktreeArgs = []string{"worktree", "list", "--porcelain"}
+)
+
+func newFakeManager(t *testing.T, backend *FakeBackend, paths ...string) *RepoManager {
+	t.Helper()
+
+	rm := NewRepoManager(&memConfigService{config: &config.Config{RepositoryPaths: paths}}, backend)
+	if err := rm.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return rm
+}
+
+func TestInit_ReadsStatusThroughBackend(t *testing.T) {
+	backend := NewFakeBackend()
+	backend.SetOutput("/repos/app", "false\ntrue\n.git\n.git\n", revParseArgs...)
+	backend.SetOutput("/repos/app", "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n"+
+		"# branch.head main\n"+
+		"# branch.upstream origin/main\n"+
+		"# branch.ab +2 -1\n"+
+		"1 .M N... 100644 100644 100644 587be6b4c3f93f93c489c0111bba5596147a26cb 587be6b4c3f93f93c489c0111bba5596147a26cb main.go\n"+
+		"? notes.txt\n", statusArgs...)
+
+	rm := newFakeManager(t, backend, "/repos/app")
+	item := findItem(t, rm, "/repos/app")
+
+	if item.HasError || item.IsBare || item.IsWorktree {
+		t.Fatalf("unexpected repository flags: %+v", item)
+	}
+	if item.UncommittedCount != 1 || item.UnpushedCount != 2 || item.BehindCount != 1 || item.UntrackedCount != 1 {
+		t.Errorf("unexpected counts: uncommitted=%d unpushed=%d behind=%d untracked=%d",
+			item.UncommittedCount, item.UnpushedCount, item.BehindCount, item.UntrackedCount)
+	}
+	if item.gitDir != "/repos/app/.git" {
+		t.Errorf("expected git dir /repos/app/.git, got %s", item.gitDir)
+	}
+	if item.Branch != "main" || item.Upstream != "origin/main" || item.Detached || item.HeadCommit != "57fb320" {
+		t.Errorf("unexpected head info: branch=%q upstream=%q detached=%v head=%q",
+			item.Branch, item.Upstream, item.Detached, item.HeadCommit)
+	}
+}
+
+func TestInit_ReadsHeadState(t *testing.T) {
+	backend := NewFakeBackend()
+	for _, path := range []string{"/repos/detached", "/repos/local", "/repos/unborn"} {
+		backend.SetOutput(path, "false\ntrue\n.git\n.git\n", revParseArgs...)
+	}
+	backend.SetOutput("/repos/detached", "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n# branch.head (detached)\n", statusArgs...)
+	backend.SetOutput("/repos/local", "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n# branch.head topic\n", statusArgs...)
+	backend.SetOutput("/repos/unborn", "# branch.oid (initial)\n# branch.head main\n", statusArgs...)
+
+	rm := newFakeManager(t, backend, "/repos/detached", "/repos/local", "/repos/unborn")
+
+	detached := findItem(t, rm, "/repos/detached")
+	if !detached.Detached || detached.Branch != "" || detached.HeadCommit != "57fb320" || detached.HasNoUpstream() {
+		t.Errorf("unexpected detached repository: %+v", detached)
+	}
+
+	local := findItem(t, rm, "/repos/local")
+	if local.Branch != "topic" || !local.HasNoUpstream() {
+		t.Errorf("expected branch without upstream: %+v", local)
+	}
+
+	unborn := findItem(t, rm, "/repos/unborn")
+	if unborn.Branch != "main" || unborn.HeadCommit != "" {
+		t.Errorf("unexpected unborn repository: %+v", unborn)
+	}
+}
+
+func TestInit_MarksInaccessibleRepository(t *testing.T) {
+	backend := NewFakeBackend()
+	rm := newFakeManager(t, backend, "/repos/missing")
+
+	item := findItem(t, rm, "/repos/missing")
+	if !item.HasError {
+		t.Error("expected repository without git data to be marked as error")
+	}
+	if summary := rm.GetSummary(); summary.TotalErrors != 1 {
+		t.Errorf("expected 1 error in summary, got %d", summary.TotalErrors)
+	}
+}
+
+func TestInit_StatusFailureMarksError(t *testing.T) {
+	backend := NewFakeBackend()
+	backend.SetOutput("/repos/app", "false\ntrue\n.git\n.git\n", revParseArgs...)
+	backend.SetError("/repos/app", errors.New("fatal: index file corrupt"), statusArgs...)
+
+	rm := newFakeManager(t, backend, "/repos/app")
+
+	if item := findItem(t, rm, "/repos/app"); !item.HasError {
+		t.Error("expected failed status read to mark the repository as error")
+	}
+}
+
+func TestInit_LoadsWorktreesOfBareRepository(t *testing.T) {
+	backend := NewFakeBackend()
+	backend.SetOutput("/repos/app.git", "true\nfalse\n.\n.\n", revParseArgs...)
+	backend.SetOutput("/repos/app.git", "worktree /repos/app.git\n"+
+		"bare\n"+
+		"\n"+
+		"worktree /repos/app/main\n"+
+		"HEAD 57fb3204b004cc07daea54ce44d3fa72a12860e2\n"+
+		"branch refs/heads/main\n"+
+		"\n"+
+		"worktree /repos/app/broken\n"+
+		"HEAD 57fb3204b004cc07daea54ce44d3fa72a12860e2\n"+
+		"branch refs/heads/feature/broken\n", worktreeArgs...)
+	backend.SetOutput("/repos/app/main", "# branch.head main\n? scratch.txt\n", statusArgs...)
+
+	rm := newFakeManager(t, backend, "/repos/app.git")
+	item := findItem(t, rm, "/repos/app.git")
+
+	if !item.IsBare {
+		t.Fatal("expected repository to be bare")
+	}
+	if len(item.SubItems) != 2 {
+		t.Fatalf("expected 2 worktrees, got %d", len(item.SubItems))
+	}
+
+	main, broken := item.SubItems[0], item.SubItems[1]
+	if main.Branch != "main" || main.HasError || main.UntrackedCount != 1 {
+		t.Errorf("unexpected main worktree: %+v", main)
+	}
+	if broken.Branch != "feature/broken" || !broken.HasError {
+		t.Errorf("expected broken worktree to be marked as error: %+v", broken)
+	}
+
+	for _, call := range backend.Calls() {
+		if call.Dir == "/repos/app.git" && strings.Join(call.Args, " ") == strings.Join(statusArgs, " ") {
+			t.Error("expected no status call for the bare repository")
+		}
+	}
+}
+
+func TestGetItems_ReturnsSnapshots(t *testing.T) {
+	backend := NewFakeBackend()
+	backend.SetOutput("/repos/app.git", "true\nfalse\n.\n.\n", revParseArgs...)
+	backend.SetOutput("/repos/app.git", "worktree /repos/app.git\nbare\n\nworktree /repos/app/main\nbranch refs/heads/main\n", worktreeArgs...)
+	backend.SetOutput("/repos/app/main", "# branch.head main\n", statusArgs...)
+
+	rm := newFakeManager(t, backend, "/repos/app.git")
+	before := rm.GetItems()[0]
+
+	backend.SetOutput("/repos/app/main", "# branch.head main\n? scratch.txt\n", statusArgs...)
+	if err := rm.ReloadStatus(); err != nil {
+		t.Fatal(err)
+	}
+
+	if before.SubItems[0].UntrackedCount != 0 {
+		t.Error("expected an earlier snapshot to be left untouched by a refresh")
+	}
+	after := rm.GetItems()[0]
+	if after.SubItems[0].UntrackedCount != 1 {
+		t.Errorf("expected the refreshed status in a new snapshot, got %+v", after.SubItems[0])
+	}
+	if after.SubItems[0].ParentRepo != after {
+		t.Error("expected the worktree snapshot to point to its repository snapshot")
+	}
+}
+
+func TestExecBackend_ReturnsGitErrorMessage(t *testing.T) {
+	isolateGit(t)
+
+	_, err := NewExecBackend().Run(context.Background(), t.TempDir(), "status")
+	if err == nil {
+		t.Fatal("expected an error outside a repository")
+	}
+	if !strings.Contains(err.Error(), "not a git repository") {
+		t.Errorf("expected git's error message, got %q", err)
+	}
+}
+
+func TestRefresh_ReadsLastCommitOncePerHead(t *testing.T) {
+	logArgs := []string{"log", "-1", commitFormat}
+
+	backend := NewFakeBackend()
+	backend.SetOutput("/repos/app", "false\ntrue\n.git\n.git\n", revParseArgs...)
+	backend.SetOutput("/repos/app", "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n# branch.head main\n", statusArgs...)
+	backend.SetOutput("/repos/app", "57fb3204b004cc07daea54ce44d3fa72a12860e2\x1f57fb320\x1fJane Doe\x1f1700000000\x1fFix parser\n", logArgs...)
+
+	rm := newFakeManager(t, backend, "/repos/app")
+	item := findItem(t, rm, "/repos/app")
+
+	want := CommitInfo{Hash: "57fb320", Subject: "Fix parser", Author: "Jane Doe", Time: time.Unix(1700000000, 0)}
+	if item.LastCommit != want {
+		t.Errorf("LastCommit = %+v, want %+v", item.LastCommit, want)
+	}
+
+	rm.RefreshItem("/repos/app")
+
+	logCalls := 0
+	for _, call := range backend.Calls() {
+		if strings.Join(call.Args, " ") == strings.Join(logArgs, " ") {
+			logCalls++
+		}
+	}
+	if logCalls != 1 {
+		t.Errorf("expected the commit to be read once while HEAD is unchanged, got %d reads", logCalls)
+	}
+}
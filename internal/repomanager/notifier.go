@@ -0,0 +1,23 @@
+package repomanager
+
+import "errors"
+
+// errWatchUnsupported is returned on platforms without a filesystem notifier.
+var errWatchUnsupported = errors.New("filesystem watching is not supported on this platform")
+
+// fsEvent is a change to an entry of a watched directory.
+type fsEvent struct {
+	Dir      string // Watched directory the event occurred in
+	Name     string // Name of the changed entry, empty for the directory itself
+	IsDir    bool   // Whether the changed entry is a directory
+	Created  bool   // Whether the entry was created or moved into the directory
+	Overflow bool   // Events were dropped; everything must be treated as changed
+}
+
+// fsNotifier watches individual directories (not recursively) for changes.
+type fsNotifier interface {
+	Add(dir string) error
+	Remove(dir string) error
+	Events() <-chan fsEvent
+	Close() error
+}
@@ -0,0 +1,138 @@
+//go:build linux
+
+package repomanager
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// inotifyMask selects the events that can change the status of a repository.
+const inotifyMask = unix.IN_CREATE | unix.IN_DELETE | unix.IN_CLOSE_WRITE | unix.IN_ATTRIB |
+	unix.IN_MOVED_FROM | unix.IN_MOVED_TO | unix.IN_DELETE_SELF | unix.IN_ONLYDIR | unix.IN_EXCL_UNLINK
+
+// inotifyNotifier implements fsNotifier with a single inotify instance.
+type inotifyNotifier struct {
+	fd     int // Raw descriptor; calling file.Fd() would switch it to blocking mode
+	file   *os.File
+	events chan fsEvent
+
+	mu   sync.Mutex
+	dirs map[int32]string // Watch descriptor to directory
+	wds  map[string]int32 // Directory to watch descriptor
+}
+
+// newNotifier creates an inotify instance and starts reading its events.
+func newNotifier() (fsNotifier, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+
+	// A non-blocking descriptor is served by the runtime poller, so Close unblocks Read
+	n := &inotifyNotifier{
+		fd:     fd,
+		file:   os.NewFile(uintptr(fd), "inotify"),
+		events: make(chan fsEvent, 256),
+		dirs:   make(map[int32]string),
+		wds:    make(map[string]int32),
+	}
+	go n.readLoop()
+	return n, nil
+}
+
+// Add starts watching a directory.
+func (n *inotifyNotifier) Add(dir string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	wd, err := unix.InotifyAddWatch(n.fd, dir, inotifyMask)
+	if err != nil {
+		return &os.PathError{Op: "inotify_add_watch", Path: dir, Err: err}
+	}
+	n.dirs[int32(wd)] = dir
+	n.wds[dir] = int32(wd)
+	return nil
+}
+
+// Remove stops watching a directory.
+func (n *inotifyNotifier) Remove(dir string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	wd, ok := n.wds[dir]
+	if !ok {
+		return nil
+	}
+	delete(n.wds, dir)
+	delete(n.dirs, wd)
+
+	_, err := unix.InotifyRmWatch(n.fd, uint32(wd))
+	return err
+}
+
+// Events returns the channel of decoded events. It is closed after Close.
+func (n *inotifyNotifier) Events() <-chan fsEvent {
+	return n.events
+}
+
+// Close releases the inotify instance and stops the read loop.
+func (n *inotifyNotifier) Close() error {
+	return n.file.Close()
+}
+
+// readLoop decodes raw inotify events until the descriptor is closed.
+func (n *inotifyNotifier) readLoop() {
+	defer close(n.events)
+
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+	for {
+		count, err := n.file.Read(buf)
+		if err != nil {
+			return
+		}
+
+		for offset := 0; offset+unix.SizeofInotifyEvent <= count; {
+			raw := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameBytes := buf[offset+unix.SizeofInotifyEvent : offset+unix.SizeofInotifyEvent+int(raw.Len)]
+			offset += unix.SizeofInotifyEvent + int(raw.Len)
+
+			if event, ok := n.decode(raw, string(bytes.TrimRight(nameBytes, "\x00"))); ok {
+				n.events <- event
+			}
+		}
+	}
+}
+
+// decode converts a raw event, dropping events for watches that no longer exist.
+func (n *inotifyNotifier) decode(raw *unix.InotifyEvent, name string) (fsEvent, bool) {
+	if raw.Mask&unix.IN_Q_OVERFLOW != 0 {
+		return fsEvent{Overflow: true}, true
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	dir, ok := n.dirs[raw.Wd]
+	if !ok {
+		return fsEvent{}, false
+	}
+
+	if raw.Mask&unix.IN_IGNORED != 0 {
+		// The kernel removed the watch because the directory is gone
+		delete(n.dirs, raw.Wd)
+		delete(n.wds, dir)
+		return fsEvent{}, false
+	}
+
+	return fsEvent{
+		Dir:     dir,
+		Name:    name,
+		IsDir:   raw.Mask&unix.IN_ISDIR != 0,
+		Created: raw.Mask&(unix.IN_CREATE|unix.IN_MOVED_TO) != 0,
+	}, true
+}
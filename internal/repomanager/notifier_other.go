@@ -0,0 +1,8 @@
+//go:build !linux
+
+package repomanager
+
+// newNotifier reports that filesystem watching is unavailable on this platform.
+func newNotifier() (fsNotifier, error) {
+	return nil, errWatchUnsupported
+}
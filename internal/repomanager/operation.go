@@ -0,0 +1,80 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Operation is a multi-step git operation that a working tree can be in the middle of.
+type Operation int
+
+const (
+	OperationNone Operation = iota
+	OperationMerge
+	OperationRebase
+	OperationCherryPick
+	OperationRevert
+	OperationBisect
+)
+
+// Operations lists every in-progress operation in display order.
+var Operations = []Operation{
+	OperationMerge,
+	OperationRebase,
+	OperationCherryPick,
+	OperationRevert,
+	OperationBisect,
+}
+
+// String returns the name of the operation as used by git.
+func (o Operation) String() string {
+	switch o {
+	case OperationMerge:
+		return "merge"
+	case OperationRebase:
+		return "rebase"
+	case OperationCherryPick:
+		return "cherry-pick"
+	case OperationRevert:
+		return "revert"
+	case OperationBisect:
+		return "bisect"
+	default:
+		return "none"
+	}
+}
+
+// InProgress reports whether an operation is in progress.
+func (o Operation) InProgress() bool {
+	return o != OperationNone
+}
+
+// operationMarkers maps the state files git leaves in a git directory to the
+// operation they belong to. Earlier entries win, since a rebase can stop on a
+// cherry-picked commit and bisecting does not prevent other operations.
+var operationMarkers = []struct {
+	name      string
+	operation Operation
+}{
+	{"rebase-merge", OperationRebase},
+	{"rebase-apply", OperationRebase},
+	{"MERGE_HEAD", OperationMerge},
+	{"CHERRY_PICK_HEAD", OperationCherryPick},
+	{"REVERT_HEAD", OperationRevert},
+	{"BISECT_LOG", OperationBisect},
+}
+
+// readOperation detects the operation in progress from the state files in a
+// worktree's own git directory.
+func readOperation(gitDir string) Operation {
+	if gitDir == "" {
+		return OperationNone
+	}
+
+	for _, marker := range operationMarkers {
+		if _, err := os.Stat(filepath.Join(gitDir, marker.name)); err == nil {
+			return marker.operation
+		}
+	}
+	return OperationNone
+}
@@ -0,0 +1,121 @@
+package repomanager
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestOperationDetection(t *testing.T) {
+	isolateGit(t)
+
+	tests := []struct {
+		name           string
+		start          func(t *testing.T, repo string)
+		want           Operation
+		wantConflicted int
+	}{
+		{
+			name: "none",
+			want: OperationNone,
+		},
+		{
+			name:           "merge",
+			start:          func(t *testing.T, repo string) { conflict(t, repo, "merge", "other") },
+			want:           OperationMerge,
+			wantConflicted: 1,
+		},
+		{
+			name:           "rebase",
+			start:          func(t *testing.T, repo string) { conflict(t, repo, "rebase", "other") },
+			want:           OperationRebase,
+			wantConflicted: 1,
+		},
+		{
+			name:           "cherry-pick",
+			start:          func(t *testing.T, repo string) { conflict(t, repo, "cherry-pick", "other") },
+			want:           OperationCherryPick,
+			wantConflicted: 1,
+		},
+		{
+			name: "revert",
+			start: func(t *testing.T, repo string) {
+				commitFile(t, repo, "file.txt", "main again\n")
+				conflict(t, repo, "revert", "--no-edit", "HEAD~1")
+			},
+			want:           OperationRevert,
+			wantConflicted: 1,
+		},
+		{
+			name: "bisect",
+			start: func(t *testing.T, repo string) {
+				git(t, repo, "bisect", "start")
+				git(t, repo, "bisect", "bad")
+			},
+			want: OperationBisect,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := filepath.Join(t.TempDir(), "repo")
+			git(t, filepath.Dir(repo), "init", "-q", "-b", "main", repo)
+			commitFile(t, repo, "file.txt", "base\n")
+			git(t, repo, "checkout", "-q", "-b", "other")
+			commitFile(t, repo, "file.txt", "other\n")
+			git(t, repo, "checkout", "-q", "main")
+			commitFile(t, repo, "file.txt", "main\n")
+
+			if tt.start != nil {
+				tt.start(t, repo)
+			}
+
+			rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+			item := findItem(t, rm, repo)
+			if item.Operation != tt.want {
+				t.Errorf("Operation = %s, want %s", item.Operation, tt.want)
+			}
+			if item.ConflictedCount != tt.wantConflicted {
+				t.Errorf("ConflictedCount = %d, want %d", item.ConflictedCount, tt.wantConflicted)
+			}
+			if got := rm.GetSummary().InProgress[tt.want]; tt.want.InProgress() && got != 1 {
+				t.Errorf("InProgress[%s] = %d, want 1", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationDetection_Worktree(t *testing.T) {
+	isolateGit(t)
+
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+	worktree := filepath.Join(root, "feature")
+	git(t, remote, "worktree", "add", "-q", "-b", "feature", worktree)
+	git(t, worktree, "bisect", "start")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{remote}})
+	item := findItem(t, rm, remote)
+	if len(item.SubItems) != 1 {
+		t.Fatalf("got %d worktrees, want 1", len(item.SubItems))
+	}
+	if item.SubItems[0].Operation != OperationBisect {
+		t.Errorf("worktree Operation = %s, want bisect", item.SubItems[0].Operation)
+	}
+	if item.Operation != OperationNone {
+		t.Errorf("bare repository Operation = %s, want none", item.Operation)
+	}
+}
+
+// conflict runs a git command that is expected to stop with conflicts.
+func conflict(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if output, err := cmd.CombinedOutput(); err == nil {
+		t.Fatalf("git %v succeeded, expected a conflict\n%s", args, output)
+	}
+}
@@ -0,0 +1,40 @@
+package repomanager
+
+import "sync"
+
+// defaultConcurrency is used when the configured concurrency is not positive.
+const defaultConcurrency = 8
+
+// runBounded calls fn for every index in [0, n) using at most limit goroutines.
+// It returns once all calls have finished.
+func runBounded(n, limit int, fn func(i int)) {
+	if n <= 0 {
+		return
+	}
+	if limit <= 0 {
+		limit = defaultConcurrency
+	}
+	if limit > n {
+		limit = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg.Wait()
+}
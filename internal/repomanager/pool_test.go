@@ -0,0 +1,72 @@
+package repomanager
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunBounded_VisitsEveryIndexOnce(t *testing.T) {
+	const n = 50
+	var mu sync.Mutex
+	seen := make(map[int]int)
+
+	runBounded(n, 4, func(i int) {
+		mu.Lock()
+		seen[i]++
+		mu.Unlock()
+	})
+
+	if len(seen) != n {
+		t.Fatalf("expected %d indexes, got %d", n, len(seen))
+	}
+	for i, count := range seen {
+		if count != 1 {
+			t.Errorf("index %d visited %d times", i, count)
+		}
+	}
+}
+
+func TestRunBounded_RespectsLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		limit    int
+		expected int
+	}{
+		{"limit below items", 20, 3, 3},
+		{"limit above items", 2, 10, 2},
+		{"zero limit uses default", 20, 0, defaultConcurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var running, peak atomic.Int64
+
+			runBounded(tt.n, tt.limit, func(i int) {
+				current := running.Add(1)
+				for {
+					old := peak.Load()
+					if current <= old || peak.CompareAndSwap(old, current) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				running.Add(-1)
+			})
+
+			if got := int(peak.Load()); got > tt.expected {
+				t.Errorf("expected at most %d concurrent calls, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRunBounded_NoItems(t *testing.T) {
+	called := false
+	runBounded(0, 4, func(i int) { called = true })
+	if called {
+		t.Error("expected fn not to be called for zero items")
+	}
+}
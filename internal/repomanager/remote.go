@@ -0,0 +1,56 @@
+package repomanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// Push pushes the checked out branch of the working tree at path to its upstream
+// branch and reports git's output while it runs. A branch without upstream is not
+// published, since picking a remote for it is left to the user.
+func (rm *RepoManager) Push(ctx context.Context, path string, output func(OutputLine)) error {
+	status, err := rm.readStatus(path)
+	if err != nil {
+		return err
+	}
+	if status.Detached {
+		return errors.New("HEAD is detached, check out a branch to push")
+	}
+	if status.Upstream == "" {
+		return fmt.Errorf("branch %s has no upstream to push to", status.Branch)
+	}
+
+	return streamCommand(ctx, rm.backend, path, output, "push", "--progress")
+}
+
+// Pull integrates the upstream branch into the checked out branch of the working
+// tree at path using the configured pull strategy, and reports git's output while it runs.
+func (rm *RepoManager) Pull(ctx context.Context, path string, output func(OutputLine)) error {
+	status, err := rm.readStatus(path)
+	if err != nil {
+		return err
+	}
+	if status.Detached {
+		return errors.New("HEAD is detached, check out a branch to pull")
+	}
+	if status.Upstream == "" {
+		return fmt.Errorf("branch %s has no upstream to pull from", status.Branch)
+	}
+
+	return streamCommand(ctx, rm.backend, path, output, "pull", "--progress", pullStrategyFlag(rm.pullStrategy))
+}
+
+// pullStrategyFlag returns the git pull flag of a pull_strategy value, fast-forward
+// only when it is not set.
+func pullStrategyFlag(strategy string) string {
+	switch strategy {
+	case config.PullStrategyRebase:
+		return "--rebase"
+	case config.PullStrategyMerge:
+		return "--no-rebase"
+	}
+	return "--ff-only"
+}
@@ -0,0 +1,141 @@
+package repomanager
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// collectOutput returns an output callback and the lines it received.
+func collectOutput() (func(OutputLine), *[]OutputLine) {
+	var lines []OutputLine
+	return func(line OutputLine) { lines = append(lines, line) }, &lines
+}
+
+func TestPushAndPull(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	work := filepath.Join(root, "work")
+	other := filepath.Join(root, "other")
+	git(t, root, "clone", "-q", remote, work)
+	git(t, root, "clone", "-q", remote, other)
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{work, other}})
+
+	commitFile(t, work, "new.txt", "new\n")
+	output, lines := collectOutput()
+	if err := rm.Push(context.Background(), work, output); err != nil {
+		t.Fatal(err)
+	}
+	if len(*lines) == 0 {
+		t.Error("expected push output to be reported")
+	}
+	if got, want := git(t, remote, "rev-parse", "main"), git(t, work, "rev-parse", "HEAD"); got != want {
+		t.Errorf("remote main = %s, want %s", got, want)
+	}
+
+	if err := rm.Pull(context.Background(), other, func(OutputLine) {}); err != nil {
+		t.Fatal(err)
+	}
+	if got := git(t, other, "log", "-1", "--format=%s"); got != "update new.txt" {
+		t.Errorf("HEAD after pull = %q", got)
+	}
+
+	// Diverged branches: the push is rejected and a fast-forward-only pull refuses to merge
+	commitFile(t, work, "work.txt", "work\n")
+	commitFile(t, other, "other.txt", "other\n")
+	git(t, other, "push", "-q", "origin", "main")
+
+	if err := rm.Push(context.Background(), work, func(OutputLine) {}); err == nil {
+		t.Error("expected a non-fast-forward push to fail")
+	}
+	err := rm.Pull(context.Background(), work, func(OutputLine) {})
+	if err == nil || strings.Contains(err.Error(), "exit status") {
+		t.Errorf("expected a fast-forward-only pull to fail with git's message, got %v", err)
+	}
+}
+
+func TestPull_Strategy(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	work := filepath.Join(root, "work")
+	other := filepath.Join(root, "other")
+	git(t, root, "clone", "-q", remote, work)
+	git(t, root, "clone", "-q", remote, other)
+
+	commitFile(t, work, "work.txt", "work\n")
+	commitFile(t, other, "other.txt", "other\n")
+	git(t, other, "push", "-q", "origin", "main")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{work}, PullStrategy: config.PullStrategyRebase})
+	if err := rm.Pull(context.Background(), work, func(OutputLine) {}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A rebase keeps history linear: the local commit sits on top of the upstream one
+	if got := git(t, work, "log", "--format=%s", "-3"); got != "update work.txt\nupdate other.txt\nupdate README.md" {
+		t.Errorf("history after rebase pull:\n%s", got)
+	}
+}
+
+func TestPush_RequiresUpstream(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	work := filepath.Join(root, "work")
+	git(t, root, "clone", "-q", remote, work)
+	git(t, work, "switch", "-q", "-c", "feature")
+	commitFile(t, work, "feature.txt", "feature\n")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{work}})
+	if err := rm.Pull(context.Background(), work, func(OutputLine) {}); err == nil {
+		t.Error("expected pulling a branch without upstream to fail")
+	}
+	err := rm.Push(context.Background(), work, func(OutputLine) {})
+	if err == nil || !strings.Contains(err.Error(), "no upstream") {
+		t.Errorf("expected pushing a branch without upstream to fail, got %v", err)
+	}
+	if got := git(t, remote, "branch", "--list", "feature"); got != "" {
+		t.Errorf("expected feature not to be published, got %q", got)
+	}
+
+	git(t, work, "switch", "-q", "--detach")
+	if err := rm.Push(context.Background(), work, func(OutputLine) {}); err == nil {
+		t.Error("expected pushing a detached HEAD to fail")
+	}
+}
+
+func TestLineWriter(t *testing.T) {
+	output, lines := collectOutput()
+	writer := &lineWriter{output: output}
+
+	writer.Write([]byte("Counting: 50%\rCounting: 10"))
+	writer.Write([]byte("0%, done.\r\nTo remote\n! [rejected]"))
+	writer.flush()
+
+	want := []OutputLine{
+		{Text: "Counting: 50%", Progress: true},
+		{Text: "Counting: 100%, done.", Progress: true},
+		{Text: "To remote"},
+		{Text: "! [rejected]"},
+	}
+	if len(*lines) != len(want) {
+		t.Fatalf("got %d lines, want %d: %+v", len(*lines), len(want), *lines)
+	}
+	for i, line := range *lines {
+		if line != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, line, want[i])
+		}
+	}
+	if writer.last != "! [rejected]" {
+		t.Errorf("last = %q", writer.last)
+	}
+}
@@ -0,0 +1,202 @@
+package repomanager
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// ScanKind describes what kind of repository a scan found.
+type ScanKind string
+
+const (
+	ScanRepository ScanKind = "repository" // Clone with a working tree
+	ScanBare       ScanKind = "bare"       // Bare repository
+	ScanWorktree   ScanKind = "worktree"   // Linked worktree of another repository
+	ScanSubmodule  ScanKind = "submodule"  // Submodule checked out inside another repository
+)
+
+// ScanResult is a repository found by a scan.
+type ScanResult struct {
+	Path    string
+	Kind    ScanKind
+	Tracked bool // Whether the path is already a repository or worktree in the list
+}
+
+// bareInternalDirs are the directories of a bare repository that never hold
+// other repositories, so the scan does not enter them.
+var bareInternalDirs = map[string]bool{
+	"objects": true, "refs": true, "hooks": true, "info": true,
+	"logs": true, "worktrees": true, "modules": true, "branches": true,
+}
+
+// Scan walks the configured roots up to the maximum depth and returns the
+// repositories, bare repositories, worktrees and submodules found below them,
+// in walk order. Roots may start with ~/ for the home directory. Directories
+// matching an exclude glob and hidden directories are not entered; a root itself
+// is always searched.
+func (rm *RepoManager) Scan(ctx context.Context, cfg config.ScanConfig) ([]ScanResult, error) {
+	maxDepth, exclude := cfg.EffectiveMaxDepth(), cfg.EffectiveExclude()
+	tracked := rm.knownPaths()
+	seen := make(map[string]bool)
+	var results []ScanResult
+
+	for _, root := range cfg.Roots {
+		root = filepath.Clean(expandHome(root))
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, fmt.Errorf("scan root %s: %w", root, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("scan root %s is not a directory", root)
+		}
+
+		err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if err != nil {
+				// Unreadable directories below the root are skipped
+				if path == root {
+					return err
+				}
+				return filepath.SkipDir
+			}
+			if !entry.IsDir() {
+				return nil
+			}
+
+			rel, _ := filepath.Rel(root, path)
+			if path != root {
+				if entry.Name() == ".git" || strings.HasPrefix(entry.Name(), ".") || scanExcluded(exclude, entry.Name(), rel) {
+					return filepath.SkipDir
+				}
+				if bareInternalDirs[entry.Name()] && isBareRepository(filepath.Dir(path)) {
+					return filepath.SkipDir
+				}
+			}
+
+			kind, ok := detectRepository(path)
+			if ok && !(kind == ScanSubmodule && cfg.SkipSubmodules) {
+				canonical := canonicalPath(path)
+				if !seen[canonical] {
+					seen[canonical] = true
+					results = append(results, ScanResult{Path: path, Kind: kind, Tracked: tracked[canonical]})
+				}
+			}
+			if ok && cfg.SkipNested {
+				return filepath.SkipDir
+			}
+			if scanDepth(rel) >= maxDepth {
+				return filepath.SkipDir
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// knownPaths returns the canonical paths of the listed repositories and their worktrees.
+func (rm *RepoManager) knownPaths() map[string]bool {
+	known := rm.trackedPaths()
+
+	var paths []string
+	rm.mu.RLock()
+	for _, item := range rm.items {
+		for _, subItem := range item.SubItems {
+			paths = append(paths, subItem.Path)
+		}
+	}
+	rm.mu.RUnlock()
+
+	for _, path := range paths {
+		known[canonicalPath(path)] = true
+	}
+	return known
+}
+
+// detectRepository reports whether dir is a repository and of which kind. A .git
+// file points to the git directory of a worktree or submodule, which lives in the
+// worktrees or modules directory of the repository it belongs to.
+func detectRepository(dir string) (ScanKind, bool) {
+	info, err := os.Lstat(filepath.Join(dir, ".git"))
+	switch {
+	case err == nil && info.IsDir():
+		return ScanRepository, true
+	case err == nil && info.Mode().IsRegular():
+		data, err := os.ReadFile(filepath.Join(dir, ".git"))
+		if err != nil {
+			return "", false
+		}
+		gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+		if !ok {
+			return "", false
+		}
+		gitDir = filepath.ToSlash(strings.TrimSpace(gitDir))
+		switch {
+		case strings.Contains(gitDir, "/worktrees/"):
+			return ScanWorktree, true
+		case strings.Contains(gitDir, "/modules/"):
+			return ScanSubmodule, true
+		default:
+			return ScanRepository, true
+		}
+	case isBareRepository(dir):
+		return ScanBare, true
+	}
+	return "", false
+}
+
+// isBareRepository reports whether dir has the layout of a git directory.
+func isBareRepository(dir string) bool {
+	for _, name := range []string{"HEAD", "objects", "refs"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// expandHome replaces a leading ~ with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
+// scanExcluded reports whether a directory matches one of the exclude globs,
+// either by name or by its path relative to the scan root.
+func scanExcluded(globs []string, name, rel string) bool {
+	rel = filepath.ToSlash(rel)
+	for _, glob := range globs {
+		if matched, _ := filepath.Match(glob, name); matched {
+			return true
+		}
+		if matched, _ := filepath.Match(glob, rel); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// scanDepth returns how many directories rel lies below the scan root.
+func scanDepth(rel string) int {
+	if rel == "." {
+		return 0
+	}
+	return strings.Count(filepath.ToSlash(rel), "/") + 1
+}
@@ -0,0 +1,129 @@
+package repomanager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestScan(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+	seed := filepath.Join(root, "seed")
+
+	work := filepath.Join(root, "work")
+	feature := filepath.Join(root, "trees", "feature")
+	git(t, root, "clone", "-q", remote, work)
+	git(t, work, "worktree", "add", "-q", "-b", "feature", feature)
+
+	// A worktree placed inside its bare repository is found, the git directory itself is not entered
+	bare := filepath.Join(root, "app.git")
+	git(t, root, "clone", "-q", "--bare", remote, bare)
+	git(t, bare, "worktree", "add", "-q", filepath.Join(bare, "topic"), "-b", "topic", "main")
+
+	app := filepath.Join(root, "app")
+	git(t, root, "clone", "-q", remote, app)
+	git(t, app, "-c", "protocol.file.allow=always", "submodule", "add", "-q", remote, "lib")
+
+	for _, dir := range []string{
+		filepath.Join(root, "node_modules", "pkg"),
+		filepath.Join(root, "deep", "a", "b", "repo"),
+	} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		git(t, dir, "init", "-q")
+	}
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{work}})
+	scan := func(cfg config.ScanConfig) map[string]ScanResult {
+		t.Helper()
+		cfg.Roots = []string{root}
+		results, err := rm.Scan(context.Background(), cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := make(map[string]ScanResult, len(results))
+		for _, result := range results {
+			found[result.Path] = result
+		}
+		return found
+	}
+
+	found := scan(config.ScanConfig{MaxDepth: 3, Exclude: config.DefaultScanExclude})
+	want := map[string]ScanResult{
+		seed:                         {Path: seed, Kind: ScanRepository},
+		remote:                       {Path: remote, Kind: ScanBare},
+		work:                         {Path: work, Kind: ScanRepository, Tracked: true},
+		feature:                      {Path: feature, Kind: ScanWorktree, Tracked: true},
+		bare:                         {Path: bare, Kind: ScanBare},
+		filepath.Join(bare, "topic"): {Path: filepath.Join(bare, "topic"), Kind: ScanWorktree},
+		app:                          {Path: app, Kind: ScanRepository},
+		filepath.Join(app, "lib"):    {Path: filepath.Join(app, "lib"), Kind: ScanSubmodule},
+	}
+	if len(found) != len(want) {
+		t.Errorf("found %d repositories, want %d: %+v", len(found), len(want), found)
+	}
+	for path, result := range want {
+		if found[path] != result {
+			t.Errorf("result for %s = %+v, want %+v", path, found[path], result)
+		}
+	}
+
+	// Deeper scans reach nested directories
+	found = scan(config.ScanConfig{MaxDepth: 4})
+	if _, ok := found[filepath.Join(root, "deep", "a", "b", "repo")]; !ok {
+		t.Error("expected a depth of 4 to reach deep/a/b/repo")
+	}
+
+	// A config file without a scan section searches to the default depth and excludes dependency directories
+	found = scan(config.ScanConfig{})
+	if _, ok := found[work]; !ok {
+		t.Error("expected the default depth to reach repositories below the root")
+	}
+	if _, ok := found[filepath.Join(root, "node_modules", "pkg")]; ok {
+		t.Error("expected node_modules to be excluded by default")
+	}
+
+	// Without exclusions dependency directories are searched
+	found = scan(config.ScanConfig{MaxDepth: 3, Exclude: []string{}})
+	if _, ok := found[filepath.Join(root, "node_modules", "pkg")]; !ok {
+		t.Error("expected an empty exclude list to search node_modules")
+	}
+
+	found = scan(config.ScanConfig{MaxDepth: 3, SkipNested: true, SkipSubmodules: true})
+	for _, path := range []string{filepath.Join(app, "lib"), filepath.Join(bare, "topic")} {
+		if _, ok := found[path]; ok {
+			t.Errorf("expected %s to be skipped", path)
+		}
+	}
+	if _, ok := found[feature]; !ok {
+		t.Error("expected a worktree outside its repository to be found")
+	}
+}
+
+func TestScan_Errors(t *testing.T) {
+	rm := NewRepoManager(&memConfigService{config: &config.Config{}}, NewExecBackend())
+	root := t.TempDir()
+	file := filepath.Join(root, "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rm.Scan(context.Background(), config.ScanConfig{Roots: []string{file}}); err == nil {
+		t.Error("expected a file root to be refused")
+	}
+	if _, err := rm.Scan(context.Background(), config.ScanConfig{Roots: []string{filepath.Join(root, "missing")}}); err == nil {
+		t.Error("expected a missing root to be refused")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := rm.Scan(ctx, config.ScanConfig{Roots: []string{root}, MaxDepth: 1}); err != context.Canceled {
+		t.Errorf("expected a cancelled scan to stop, got %v", err)
+	}
+}
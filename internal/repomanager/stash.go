@@ -0,0 +1,127 @@
+package repomanager
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StashEntry describes a single entry of a repository's stash list.
+type StashEntry struct {
+	Ref     string    // Reflog selector, e.g. "stash@{0}"
+	Oid     string    // Commit hash of the stash
+	Branch  string    // Branch the stash was created on, "(no branch)" when HEAD was detached
+	Message string    // Stash message without the branch prefix
+	Time    time.Time // When the stash was created
+}
+
+// stashFormat prints one stash per line with fields separated by the unit separator.
+const stashFormat = "--format=%gd%x1f%H%x1f%ct%x1f%gs"
+
+// ListStashes returns the stash entries of the repository at path, newest first.
+// Worktrees of the same repository share a single stash list.
+func (rm *RepoManager) ListStashes(path string) ([]StashEntry, error) {
+	output, err := rm.runGitCommand(path, "stash", "list", stashFormat)
+	if err != nil {
+		return nil, err
+	}
+	return parseStashList(string(output)), nil
+}
+
+// parseStashList parses the output of git stash list using stashFormat.
+func parseStashList(output string) []StashEntry {
+	var entries []StashEntry
+
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.SplitN(line, "\x1f", 4)
+		if len(fields) < 4 {
+			continue
+		}
+
+		entry := StashEntry{
+			Ref: fields[0],
+			Oid: fields[1],
+		}
+		if seconds, err := strconv.ParseInt(fields[2], 10, 64); err == nil {
+			entry.Time = time.Unix(seconds, 0)
+		}
+		entry.Branch, entry.Message = parseStashSubject(fields[3])
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// parseStashSubject splits a stash subject such as "WIP on main: 1a2b3c4 subject"
+// or "On main: message" into the branch and the message.
+func parseStashSubject(subject string) (branch, message string) {
+	rest, wip := strings.CutPrefix(subject, "WIP on ")
+	if !wip {
+		var ok bool
+		if rest, ok = strings.CutPrefix(subject, "On "); !ok {
+			return "", subject
+		}
+	}
+
+	branch, message, ok := strings.Cut(rest, ": ")
+	if !ok {
+		return "", subject
+	}
+	if wip {
+		message = "WIP " + message
+	}
+	return branch, message
+}
+
+// ApplyStash applies a stash entry to the working tree and keeps it in the stash list.
+func (rm *RepoManager) ApplyStash(path string, entry StashEntry) error {
+	_, err := rm.runGitCommand(path, "stash", "apply", "--quiet", entry.Oid)
+	return err
+}
+
+// PopStash applies a stash entry and removes it from the stash list.
+func (rm *RepoManager) PopStash(path string, entry StashEntry) error {
+	if err := rm.verifyStash(path, entry); err != nil {
+		return err
+	}
+	_, err := rm.runGitCommand(path, "stash", "pop", "--quiet", entry.Ref)
+	return err
+}
+
+// DropStash removes a stash entry without applying it.
+func (rm *RepoManager) DropStash(path string, entry StashEntry) error {
+	if err := rm.verifyStash(path, entry); err != nil {
+		return err
+	}
+	_, err := rm.runGitCommand(path, "stash", "drop", "--quiet", entry.Ref)
+	return err
+}
+
+// verifyStash makes sure the reflog selector of an entry still points at the same
+// stash, since selectors shift whenever a stash is created or dropped.
+func (rm *RepoManager) verifyStash(path string, entry StashEntry) error {
+	output, err := rm.runGitCommand(path, "rev-parse", "--verify", "--quiet", entry.Ref)
+	if err != nil || strings.TrimSpace(string(output)) != entry.Oid {
+		return fmt.Errorf("%s changed since the stash list was loaded, refresh and try again", entry.Ref)
+	}
+	return nil
+}
+
+// readStashCount counts the stash entries of a repository from the stash reflog
+// in its common git directory, without spawning git.
+func readStashCount(commonDir string) int {
+	if commonDir == "" {
+		return 0
+	}
+
+	data, err := os.ReadFile(filepath.Join(commonDir, "logs", "refs", "stash"))
+	if err != nil {
+		return 0
+	}
+	return bytes.Count(data, []byte("\n"))
+}
@@ -0,0 +1,110 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestParseStashSubject(t *testing.T) {
+	tests := []struct {
+		subject     string
+		wantBranch  string
+		wantMessage string
+	}{
+		{"WIP on main: 1a2b3c4 add parser", "main", "WIP 1a2b3c4 add parser"},
+		{"On feature/x: half done", "feature/x", "half done"},
+		{"On (no branch): detached work", "(no branch)", "detached work"},
+		{"custom subject", "", "custom subject"},
+	}
+
+	for _, tt := range tests {
+		branch, message := parseStashSubject(tt.subject)
+		if branch != tt.wantBranch || message != tt.wantMessage {
+			t.Errorf("parseStashSubject(%q) = %q, %q, want %q, %q", tt.subject, branch, message, tt.wantBranch, tt.wantMessage)
+		}
+	}
+}
+
+func TestStashes(t *testing.T) {
+	isolateGit(t)
+
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	git(t, root, "init", "-q", "-b", "main", repo)
+	commitFile(t, repo, "file.txt", "one\n")
+
+	writeStash := func(content, message string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(repo, "file.txt"), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		git(t, repo, "stash", "push", "-q", "-m", message)
+	}
+	writeStash("two\n", "first")
+	writeStash("three\n", "second")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	item := findItem(t, rm, repo)
+	if item.StashCount != 2 {
+		t.Fatalf("StashCount = %d, want 2", item.StashCount)
+	}
+	if summary := rm.GetSummary(); summary.TotalStashes != 2 {
+		t.Errorf("TotalStashes = %d, want 2", summary.TotalStashes)
+	}
+
+	entries, err := rm.ListStashes(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d stashes, want 2", len(entries))
+	}
+	if entries[0].Ref != "stash@{0}" || entries[0].Branch != "main" || entries[0].Message != "second" || entries[0].Time.IsZero() {
+		t.Errorf("unexpected newest stash %+v", entries[0])
+	}
+
+	// Apply keeps the entry
+	if err := rm.ApplyStash(repo, entries[1]); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(filepath.Join(repo, "file.txt")); string(content) != "two\n" {
+		t.Errorf("file content after apply = %q", content)
+	}
+	git(t, repo, "checkout", "-q", "--", "file.txt")
+
+	// Selectors that moved since the list was loaded are refused
+	writeStash("four\n", "third")
+	if err := rm.DropStash(repo, entries[0]); err == nil {
+		t.Fatal("expected dropping an outdated selector to fail")
+	}
+
+	entries, err = rm.ListStashes(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.DropStash(repo, entries[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.PopStash(repo, entries[1]); err == nil {
+		t.Fatal("expected popping an outdated selector to fail")
+	}
+
+	entries, err = rm.ListStashes(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.PopStash(repo, entries[0]); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(filepath.Join(repo, "file.txt")); string(content) != "three\n" {
+		t.Errorf("file content after pop = %q", content)
+	}
+
+	rm.RefreshItem(repo)
+	if item.StashCount != 1 {
+		t.Errorf("StashCount after drop and pop = %d, want 1", item.StashCount)
+	}
+}
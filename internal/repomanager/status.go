@@ -0,0 +1,122 @@
+package repomanager
+
+import (
+	"strconv"
+	"strings"
+)
+
+// GitStatus is the structured result of a single `git status --porcelain=v2 --branch` call.
+type GitStatus struct {
+	Head       string // Commit hash of HEAD, "(initial)" on an unborn branch
+	Branch     string // Current branch name, empty when HEAD is detached
+	Detached   bool   // Whether HEAD is detached
+	Upstream   string // Upstream branch (e.g. "origin/main"), empty when none is configured
+	Ahead      int    // Commits ahead of the upstream
+	Behind     int    // Commits behind the upstream
+	Changed    int    // Tracked files with staged, unstaged or conflicting changes
+	Staged     int    // Files with changes in the index
+	Unstaged   int    // Files with changes in the working tree
+	Untracked  int    // Untracked files and directories
+	Conflicted int    // Unmerged files
+	Renamed    int    // Renamed or copied files
+}
+
+// ShortHead returns the abbreviated HEAD commit hash, or an empty string on an unborn branch.
+func (s GitStatus) ShortHead() string {
+	if len(s.Head) < 7 || s.Head == "(initial)" {
+		return ""
+	}
+	return s.Head[:7]
+}
+
+// readStatus runs git status once and parses it into a GitStatus.
+func (rm *RepoManager) readStatus(path string) (GitStatus, error) {
+	output, err := rm.runGitCommand(path, "status", "--porcelain=v2", "--branch")
+	if err != nil {
+		return GitStatus{}, err
+	}
+	return parsePorcelainV2(string(output)), nil
+}
+
+// parsePorcelainV2 parses the output of git status --porcelain=v2 --branch.
+func parsePorcelainV2(output string) GitStatus {
+	var status GitStatus
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		switch line[0] {
+		case '#':
+			parseBranchHeader(&status, line)
+		case '1':
+			status.Changed++
+			countXY(&status, line)
+		case '2':
+			status.Changed++
+			status.Renamed++
+			countXY(&status, line)
+		case 'u':
+			status.Changed++
+			status.Conflicted++
+		case '?':
+			status.Untracked++
+		}
+	}
+
+	return status
+}
+
+// parseBranchHeader parses a single "# branch.*" header line.
+func parseBranchHeader(status *GitStatus, line string) {
+	parts := strings.SplitN(line, " ", 3)
+	if len(parts) < 3 {
+		return
+	}
+
+	key := parts[1]
+	value := parts[2]
+
+	switch key {
+	case "branch.oid":
+		status.Head = value
+	case "branch.head":
+		if value == "(detached)" {
+			status.Detached = true
+		} else {
+			status.Branch = value
+		}
+	case "branch.upstream":
+		status.Upstream = value
+	case "branch.ab":
+		for _, field := range strings.Fields(value) {
+			count, err := strconv.Atoi(field[1:])
+			if err != nil {
+				continue
+			}
+			switch field[0] {
+			case '+':
+				status.Ahead = count
+			case '-':
+				status.Behind = count
+			}
+		}
+	}
+}
+
+// countXY counts the staged and unstaged parts of an ordinary or renamed entry.
+func countXY(status *GitStatus, line string) {
+	if len(line) < 4 {
+		return
+	}
+
+	// The XY field follows the entry type, "." means unmodified
+	x, y := line[2], line[3]
+	if x != '.' {
+		status.Staged++
+	}
+	if y != '.' {
+		status.Unstaged++
+	}
+}
@@ -0,0 +1,84 @@
+package repomanager
+
+import "testing"
+
+func TestParsePorcelainV2(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		expected GitStatus
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			expected: GitStatus{},
+		},
+		{
+			name: "clean branch in sync",
+			output: "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n" +
+				"# branch.head main\n" +
+				"# branch.upstream origin/main\n" +
+				"# branch.ab +0 -0\n",
+			expected: GitStatus{
+				Head:     "57fb3204b004cc07daea54ce44d3fa72a12860e2",
+				Branch:   "main",
+				Upstream: "origin/main",
+			},
+		},
+		{
+			name: "ahead and behind",
+			output: "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n" +
+				"# branch.head feature/x\n" +
+				"# branch.upstream origin/feature/x\n" +
+				"# branch.ab +3 -2\n",
+			expected: GitStatus{
+				Head:     "57fb3204b004cc07daea54ce44d3fa72a12860e2",
+				Branch:   "feature/x",
+				Upstream: "origin/feature/x",
+				Ahead:    3,
+				Behind:   2,
+			},
+		},
+		{
+			name: "detached head on unborn repository",
+			output: "# branch.oid (initial)\n" +
+				"# branch.head (detached)\n",
+			expected: GitStatus{
+				Head:     "(initial)",
+				Detached: true,
+			},
+		},
+		{
+			name: "mixed entries",
+			output: "# branch.oid 57fb3204b004cc07daea54ce44d3fa72a12860e2\n" +
+				"# branch.head main\n" +
+				"1 AM N... 000000 100644 100644 0000000000000000000000000000000000000000 587be6b4c3f93f93c489c0111bba5596147a26cb a.txt\n" +
+				"1 .M N... 100644 100644 100644 587be6b4c3f93f93c489c0111bba5596147a26cb 587be6b4c3f93f93c489c0111bba5596147a26cb b.txt\n" +
+				"1 D. N... 100644 000000 000000 587be6b4c3f93f93c489c0111bba5596147a26cb 0000000000000000000000000000000000000000 c.txt\n" +
+				"2 R. N... 100644 100644 100644 587be6b4c3f93f93c489c0111bba5596147a26cb 587be6b4c3f93f93c489c0111bba5596147a26cb R100 new.txt\told.txt\n" +
+				"u UU N... 100644 100644 100644 100644 587be6b4c3f93f93c489c0111bba5596147a26cb 587be6b4c3f93f93c489c0111bba5596147a26cb 587be6b4c3f93f93c489c0111bba5596147a26cb conflict.txt\n" +
+				"? untracked.txt\n" +
+				"? dir/\n" +
+				"! ignored.log\n",
+			expected: GitStatus{
+				Head:       "57fb3204b004cc07daea54ce44d3fa72a12860e2",
+				Branch:     "main",
+				Changed:    5,
+				Staged:     3,
+				Unstaged:   2,
+				Untracked:  2,
+				Conflicted: 1,
+				Renamed:    1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parsePorcelainV2(tt.output)
+			if result != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, result)
+			}
+		})
+	}
+}
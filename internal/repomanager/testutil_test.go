@@ -0,0 +1,103 @@
+package repomanager
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// memConfigService is an in-memory config.ConfigService for tests.
+type memConfigService struct {
+	config *config.Config
+}
+
+func (s *memConfigService) Load() (*config.Config, error) {
+	return s.config, nil
+}
+
+func (s *memConfigService) Save(cfg *config.Config) error {
+	s.config = cfg
+	return nil
+}
+
+// isolateGit makes git ignore the user's configuration and gives commits a fixed identity.
+func isolateGit(t *testing.T) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	t.Setenv("GIT_CONFIG_GLOBAL", os.DevNull)
+	t.Setenv("GIT_CONFIG_NOSYSTEM", "1")
+	t.Setenv("GIT_AUTHOR_NAME", "Test")
+	t.Setenv("GIT_AUTHOR_EMAIL", "test@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Test")
+	t.Setenv("GIT_COMMITTER_EMAIL", "test@example.com")
+}
+
+// git runs a git command in dir and fails the test on error.
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// commitFile writes a file and commits it.
+func commitFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	git(t, dir, "add", name)
+	git(t, dir, "commit", "-q", "-m", "update "+name)
+}
+
+// setupRemote creates a bare remote with one commit on main and returns its path.
+func setupRemote(t *testing.T, root string) string {
+	t.Helper()
+
+	seed := filepath.Join(root, "seed")
+	git(t, root, "init", "-q", "-b", "main", seed)
+	commitFile(t, seed, "README.md", "seed\n")
+
+	remote := filepath.Join(root, "remote.git")
+	git(t, root, "clone", "-q", "--bare", seed, remote)
+	return remote
+}
+
+// newTestManager creates an initialized RepoManager tracking the given paths.
+func newTestManager(t *testing.T, cfg *config.Config) *RepoManager {
+	t.Helper()
+
+	rm := NewRepoManager(&memConfigService{config: cfg}, NewExecBackend())
+	if err := rm.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return rm
+}
+
+// findItem returns the tracked item with the given path. It is the item the
+// manager refreshes, so tests see the outcome of later operations on it.
+func findItem(t *testing.T, rm *RepoManager, path string) *RepoItem {
+	t.Helper()
+
+	for _, item := range rm.sharedItems() {
+		if item.Path == path {
+			return item
+		}
+	}
+	t.Fatalf("no item for %s", path)
+	return nil
+}
@@ -1,27 +1,54 @@
 // Package repomanager provides repository management with hierarchical items and worktrees.
 package repomanager
 
+import "time"
+
 // WorktreeInfo contains information about a Git worktree.
 type WorktreeInfo struct {
-	Path   string
-	Branch string
-	Bare   bool
+	Path        string
+	Branch      string
+	Bare        bool
+	Detached    bool   // Whether HEAD is detached
+	Locked      bool   // Whether the worktree is locked against pruning, moving and removal
+	LockReason  string // Reason given when locking, may be empty
+	Prunable    bool   // Whether git worktree prune would remove the worktree
+	PruneReason string // Why the worktree is prunable, e.g. its directory is missing
 }
 
 // RepoItem represents a repository that can have sub-items (worktrees).
 type RepoItem struct {
 	Name             string
 	Path             string
+	Branch           string // Checked out branch, empty when HEAD is detached or the repository is bare
+	Upstream         string // Upstream branch (e.g. "origin/main"), empty when none is configured
+	Detached         bool   // Whether HEAD is detached
+	HeadCommit       string // Abbreviated commit hash of HEAD, empty on an unborn branch
 	HasUncommitted   bool
 	HasUnpushed      bool
+	HasBehind        bool
 	HasUntracked     bool
 	HasError         bool
 	IsWorktree       bool
 	IsBare           bool
 	UncommittedCount int
 	UnpushedCount    int
+	BehindCount      int
 	UntrackedCount   int
+	StagedCount      int
+	UnstagedCount    int
+	ConflictedCount  int
+	RenamedCount     int
+	StashCount       int        // Entries in the stash list, shared by all worktrees of the repository
+	Operation        Operation  // Merge, rebase or other operation in progress
+	LastCommit       CommitInfo // Commit HEAD points to
+	LastFetch        time.Time  // Time of the last successful fetch, zero if unknown
+	FetchError       string     // Error of the last fetch attempt, empty if it succeeded
+	FetchStale       bool       // Whether the remote state is older than the configured threshold
 	SubItems         []*SubItem // Worktrees for this repository
+	gitDir           string     // Absolute path of the git directory
+	commonDir        string     // Absolute path of the git directory shared by all worktrees
+	commitOid        string     // Full hash LastCommit was read for
+	canonical        string     // Path as canonicalPath returns it, resolved when the item is created
 }
 
 // SubItem represents a worktree or other sub-component of a repository.
@@ -29,20 +56,58 @@ type SubItem struct {
 	Name             string
 	Path             string
 	Branch           string
+	Upstream         string // Upstream branch (e.g. "origin/main"), empty when none is configured
+	Detached         bool   // Whether HEAD is detached
+	HeadCommit       string // Abbreviated commit hash of HEAD, empty on an unborn branch
 	HasUncommitted   bool
 	HasUnpushed      bool
+	HasBehind        bool
 	HasUntracked     bool
 	HasError         bool
 	UncommittedCount int
 	UnpushedCount    int
+	BehindCount      int
 	UntrackedCount   int
+	StagedCount      int
+	UnstagedCount    int
+	ConflictedCount  int
+	RenamedCount     int
+	StashCount       int        // Entries in the stash list shared with the parent repository
+	Operation        Operation  // Merge, rebase or other operation in progress
+	LastCommit       CommitInfo // Commit HEAD points to
+	Locked           bool       // Whether the worktree is locked against pruning, moving and removal
+	LockReason       string     // Reason given when locking, may be empty
+	Prunable         bool       // Whether git worktree prune would remove the worktree
+	PruneReason      string     // Why the worktree is prunable, e.g. its directory is missing
 	ParentRepo       *RepoItem
+	commitOid        string // Full hash LastCommit was read for
+}
+
+// HasNoUpstream reports whether the checked out branch has no upstream configured.
+func (item *RepoItem) HasNoUpstream() bool {
+	return item.Branch != "" && item.Upstream == ""
+}
+
+// HasNoUpstream reports whether the checked out branch has no upstream configured.
+func (subItem *SubItem) HasNoUpstream() bool {
+	return subItem.Branch != "" && subItem.Upstream == ""
 }
 
 // SummaryData holds aggregated summary information.
 type SummaryData struct {
 	TotalUncommitted int
 	TotalUnpushed    int
+	TotalBehind      int
+	TotalStale       int
+	TotalStashes     int
+	InProgress       map[Operation]int // Repositories and worktrees per in-progress operation
 	TotalUntracked   int
 	TotalErrors      int
 }
+
+// StatusUpdate reports that a single repository or worktree finished refreshing.
+type StatusUpdate struct {
+	Path      string // Path of the refreshed repository or worktree
+	Completed int    // Number of items refreshed so far
+	Total     int    // Total number of items in the refresh
+}
@@ -0,0 +1,345 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+)
+
+// ignoredGitDirEntries are files and directories inside a git directory whose
+// changes never affect the status shown for a repository.
+var ignoredGitDirEntries = map[string]bool{
+	"objects":        true,
+	"logs":           true,
+	"hooks":          true,
+	"info":           true,
+	"description":    true,
+	"COMMIT_EDITMSG": true,
+	"gc.pid":         true,
+	"gc.log":         true,
+}
+
+// Watcher keeps repository statuses current by watching working trees and git directories.
+// Changes are debounced, only the affected items are refreshed, and the paths of
+// refreshed items are reported on the Changes channel.
+type Watcher struct {
+	repoManager *RepoManager
+	debounce    time.Duration
+	maxDirs     int
+	ignore      []string
+	notifier    fsNotifier
+
+	mu       sync.Mutex
+	targets  map[string]watchTarget // Watched directory to what its changes affect
+	itemDirs map[string][]string    // Item path to the directories watched for it
+	dirCount map[string]int         // Working tree directories watched per item
+	limited  map[string]bool        // Items that reached the directory limit
+
+	changes chan []string
+	done    chan struct{}
+	once    sync.Once
+}
+
+// watchTarget describes a watched directory.
+type watchTarget struct {
+	items     []string // Paths of the items whose status the directory affects
+	gitDir    bool     // Whether the directory belongs to a git directory rather than a working tree
+	recursive bool     // Whether new subdirectories are watched as well
+}
+
+// NewWatcher creates a watcher for all repositories of the manager.
+// It fails on platforms without filesystem notifications.
+func NewWatcher(repoManager *RepoManager, watchConfig config.WatchConfig) (*Watcher, error) {
+	notifier, err := newNotifier()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Watcher{
+		repoManager: repoManager,
+		debounce:    watchConfig.EffectiveDebounce(),
+		maxDirs:     watchConfig.EffectiveMaxDirectories(),
+		ignore:      watchConfig.EffectiveIgnore(),
+		notifier:    notifier,
+		targets:     make(map[string]watchTarget),
+		itemDirs:    make(map[string][]string),
+		dirCount:    make(map[string]int),
+		limited:     make(map[string]bool),
+		changes:     make(chan []string),
+		done:        make(chan struct{}),
+	}
+	return w, nil
+}
+
+// Start watches all current items and processes events in the background.
+// Walking the working trees happens in the background as well, so startup is not delayed.
+func (w *Watcher) Start() {
+	go func() {
+		w.sync()
+		w.run()
+	}()
+}
+
+// Stop stops watching and releases the notifier.
+func (w *Watcher) Stop() {
+	w.once.Do(func() {
+		close(w.done)
+		w.notifier.Close()
+
+		// Drain events still queued so the notifier's reader can exit
+		go func() {
+			for range w.notifier.Events() {
+			}
+		}()
+	})
+}
+
+// Changes returns a channel receiving the paths of items refreshed after a change.
+func (w *Watcher) Changes() <-chan []string {
+	return w.changes
+}
+
+// run collects events and refreshes the affected items once the debounce period has passed.
+func (w *Watcher) run() {
+	pending := make(map[string]bool)
+	var timer <-chan time.Time
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case event, ok := <-w.notifier.Events():
+			if !ok {
+				return
+			}
+			for _, path := range w.handleEvent(event) {
+				pending[path] = true
+			}
+			if len(pending) > 0 && timer == nil {
+				timer = time.After(w.debounce)
+			}
+		case <-timer:
+			timer = nil
+			paths := make([]string, 0, len(pending))
+			for path := range pending {
+				paths = append(paths, path)
+			}
+			pending = make(map[string]bool)
+
+			w.refresh(paths)
+
+			select {
+			case w.changes <- paths:
+			case <-w.done:
+				return
+			}
+		}
+	}
+}
+
+// refresh re-reads the changed items and starts watching worktrees that appeared.
+func (w *Watcher) refresh(paths []string) {
+	runBounded(len(paths), w.repoManager.concurrency, func(i int) {
+		w.repoManager.RefreshItem(paths[i])
+	})
+	w.sync()
+}
+
+// handleEvent maps an event to the items it affects and watches newly created directories.
+func (w *Watcher) handleEvent(event fsEvent) []string {
+	if event.Overflow {
+		return w.allItemPaths()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	target, ok := w.targets[event.Dir]
+	if !ok {
+		return nil
+	}
+
+	if target.gitDir {
+		if strings.HasSuffix(event.Name, ".lock") || ignoredGitDirEntries[event.Name] {
+			return nil
+		}
+	} else if event.Name == ".git" || (event.IsDir && w.isIgnored(event.Name)) {
+		return nil
+	}
+
+	if event.IsDir && event.Created && target.recursive {
+		w.watchTreeLocked(filepath.Join(event.Dir, event.Name), target, target.items[0])
+	}
+	return target.items
+}
+
+// sync starts watching items that are not watched yet and stops watching removed ones.
+func (w *Watcher) sync() {
+	wanted := make(map[string]bool)
+
+	for _, item := range w.repoManager.GetItems() {
+		bare, hasError := item.IsBare, item.HasError
+		gitDir, commonDir := item.gitDir, item.commonDir
+		subItems := item.SubItems
+
+		if hasError {
+			continue
+		}
+
+		wanted[item.Path] = true
+		affected := []string{item.Path}
+		for _, subItem := range subItems {
+			wanted[subItem.Path] = true
+			affected = append(affected, subItem.Path)
+		}
+
+		// Refs and packed-refs are shared by every worktree of a repository
+		refs := watchTarget{items: affected, gitDir: true, recursive: true}
+		shared := watchTarget{items: affected, gitDir: true}
+
+		w.mu.Lock()
+		if _, watched := w.itemDirs[item.Path]; !watched {
+			if bare {
+				w.watchDirLocked(commonDir, shared, item.Path)
+				w.watchTreeLocked(filepath.Join(commonDir, "refs"), refs, item.Path)
+			} else {
+				w.watchTreeLocked(item.Path, watchTarget{items: affected, recursive: true}, item.Path)
+				w.watchDirLocked(gitDir, shared, item.Path)
+				if commonDir != gitDir {
+					w.watchDirLocked(commonDir, shared, item.Path)
+				}
+				w.watchTreeLocked(filepath.Join(commonDir, "refs"), refs, item.Path)
+			}
+			// Adding or removing a worktree changes this directory in any repository
+			w.watchDirLocked(filepath.Join(commonDir, "worktrees"), watchTarget{items: []string{item.Path}, gitDir: true}, item.Path)
+		}
+
+		for _, subItem := range subItems {
+			if _, watched := w.itemDirs[subItem.Path]; watched {
+				continue
+			}
+			items := []string{subItem.Path}
+			w.watchTreeLocked(subItem.Path, watchTarget{items: items, recursive: true}, subItem.Path)
+			if subGitDir := linkedGitDir(subItem.Path); subGitDir != "" {
+				w.watchDirLocked(subGitDir, watchTarget{items: items, gitDir: true}, subItem.Path)
+			}
+		}
+		w.mu.Unlock()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for path, dirs := range w.itemDirs {
+		if wanted[path] {
+			continue
+		}
+		for _, dir := range dirs {
+			w.notifier.Remove(dir)
+			delete(w.targets, dir)
+		}
+		delete(w.itemDirs, path)
+		delete(w.dirCount, path)
+		delete(w.limited, path)
+	}
+}
+
+// watchTreeLocked watches root and its subdirectories. Working tree directories count
+// towards the owner's limit and skip ignored names and nested repositories; git
+// directories are always watched completely.
+func (w *Watcher) watchTreeLocked(root string, target watchTarget, owner string) {
+	workTree := !target.gitDir
+
+	filepath.WalkDir(root, func(path string, entry os.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return nil
+		}
+
+		if workTree && path != root {
+			if entry.Name() == ".git" || w.isIgnored(entry.Name()) {
+				return filepath.SkipDir
+			}
+			if _, err := os.Lstat(filepath.Join(path, ".git")); err == nil {
+				// Nested repositories are tracked on their own
+				return filepath.SkipDir
+			}
+		}
+
+		if workTree && w.dirCount[owner] >= w.maxDirs {
+			if !w.limited[owner] {
+				w.limited[owner] = true
+				logging.Get().Warn("directory watch limit reached, deeper changes need a manual refresh",
+					"path", owner, "limit", w.maxDirs)
+			}
+			return filepath.SkipAll
+		}
+
+		if !w.watchDirLocked(path, target, owner) {
+			return filepath.SkipAll
+		}
+		if workTree {
+			w.dirCount[owner]++
+		}
+		return nil
+	})
+}
+
+// watchDirLocked watches a single directory and records which items it affects.
+// It returns false when the system watch limit is exhausted.
+func (w *Watcher) watchDirLocked(dir string, target watchTarget, owner string) bool {
+	if _, watched := w.targets[dir]; watched {
+		return true
+	}
+
+	if err := w.notifier.Add(dir); err != nil {
+		if os.IsNotExist(err) {
+			return true
+		}
+		logging.Get().Warn("failed to watch directory", "path", dir, "error", err)
+		return false
+	}
+
+	w.targets[dir] = target
+	w.itemDirs[owner] = append(w.itemDirs[owner], dir)
+	return true
+}
+
+// isIgnored reports whether a directory name matches one of the ignore globs.
+func (w *Watcher) isIgnored(name string) bool {
+	for _, pattern := range w.ignore {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// allItemPaths returns the paths of every watched item.
+func (w *Watcher) allItemPaths() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	paths := make([]string, 0, len(w.itemDirs))
+	for path := range w.itemDirs {
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// linkedGitDir returns the git directory of a linked worktree from its ".git" file.
+func linkedGitDir(worktreePath string) string {
+	content, err := os.ReadFile(filepath.Join(worktreePath, ".git"))
+	if err != nil {
+		return ""
+	}
+
+	gitDir, found := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir: ")
+	if !found {
+		return ""
+	}
+	return resolveGitPath(worktreePath, gitDir)
+}
@@ -0,0 +1,175 @@
+package repomanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// startTestWatcher watches the manager's items and waits for the initial walk to finish.
+func startTestWatcher(t *testing.T, rm *RepoManager, watchConfig config.WatchConfig) *Watcher {
+	t.Helper()
+
+	w, err := NewWatcher(rm, watchConfig)
+	if err == errWatchUnsupported {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.sync()
+	go w.run()
+	t.Cleanup(w.Stop)
+	return w
+}
+
+// expectChange waits for a batch of refreshed items containing path.
+func expectChange(t *testing.T, w *Watcher, path string) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case paths := <-w.Changes():
+			for _, changed := range paths {
+				if changed == path {
+					return
+				}
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for a change of %s", path)
+		}
+	}
+}
+
+// expectNoChange fails when any change is reported within a short period.
+func expectNoChange(t *testing.T, w *Watcher) {
+	t.Helper()
+
+	select {
+	case paths := <-w.Changes():
+		t.Fatalf("expected no change, got %v", paths)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestWatcher_RefreshesChangedRepository(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+	writeFile(t, filepath.Join(repo, "node_modules", "pkg", "index.js"), "ignored\n")
+	writeFile(t, filepath.Join(repo, "src", "keep.txt"), "nested\n")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	w := startTestWatcher(t, rm, config.WatchConfig{
+		Debounce: 20 * time.Millisecond,
+		Ignore:   config.DefaultWatchIgnore,
+	})
+	item := findItem(t, rm, repo)
+
+	// Changes in ignored directories are not reported
+	writeFile(t, filepath.Join(repo, "node_modules", "pkg", "index.js"), "still ignored\n")
+	expectNoChange(t, w)
+
+	// Working tree changes, including in new directories, refresh the item
+	writeFile(t, filepath.Join(repo, "notes.txt"), "notes\n")
+	expectChange(t, w, repo)
+	if item.UntrackedCount != 3 {
+		t.Errorf("expected 3 untracked entries after the change, got %d", item.UntrackedCount)
+	}
+
+	os.MkdirAll(filepath.Join(repo, "docs"), 0o755)
+	expectChange(t, w, repo)
+	writeFile(t, filepath.Join(repo, "docs", "guide.md"), "guide\n")
+	expectChange(t, w, repo)
+
+	// Commits touch the index, HEAD and refs in the git directory
+	git(t, repo, "add", "-A")
+	git(t, repo, "commit", "-q", "-m", "add files")
+	expectChange(t, w, repo)
+	if item.HasUntracked || item.UnpushedCount != 1 {
+		t.Errorf("expected a clean repository one commit ahead, got untracked=%d unpushed=%d",
+			item.UntrackedCount, item.UnpushedCount)
+	}
+}
+
+func TestWatcher_RespectsDirectoryLimit(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+	os.MkdirAll(filepath.Join(repo, "deep"), 0o755)
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{repo}})
+	w := startTestWatcher(t, rm, config.WatchConfig{Debounce: 20 * time.Millisecond, MaxDirectories: 1})
+
+	writeFile(t, filepath.Join(repo, "deep", "file.txt"), "beyond the limit\n")
+	expectNoChange(t, w)
+
+	writeFile(t, filepath.Join(repo, "top.txt"), "within the limit\n")
+	expectChange(t, w, repo)
+}
+
+func TestWatcher_DefaultsWithoutWatchSection(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	repo := filepath.Join(root, "repo")
+	git(t, root, "clone", "-q", remote, repo)
+	os.MkdirAll(filepath.Join(repo, "node_modules", "pkg"), 0o755)
+	os.MkdirAll(filepath.Join(repo, "src"), 0o755)
+
+	cfg := &config.Config{RepositoryPaths: []string{repo}}
+	rm := newTestManager(t, cfg)
+	w := startTestWatcher(t, rm, cfg.Watch)
+
+	if w.debounce != config.DefaultWatchDebounce || w.maxDirs != config.DefaultWatchMaxDirectories {
+		t.Errorf("expected the default debounce and limit, got %v and %d", w.debounce, w.maxDirs)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.targets[filepath.Join(repo, "node_modules")]; ok {
+		t.Error("expected node_modules to be ignored without an ignore setting")
+	}
+	if _, ok := w.targets[filepath.Join(repo, "src")]; !ok {
+		t.Error("expected src to be watched")
+	}
+}
+
+func TestWatcher_TracksWorktreesOfBareRepository(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+	worktree := filepath.Join(root, "wt-main")
+	git(t, remote, "worktree", "add", "-q", worktree, "main")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{remote}})
+	w := startTestWatcher(t, rm, config.WatchConfig{Debounce: 20 * time.Millisecond})
+
+	writeFile(t, filepath.Join(worktree, "change.txt"), "change\n")
+	expectChange(t, w, worktree)
+
+	// New worktrees are picked up and watched as well
+	added := filepath.Join(root, "wt-feature")
+	git(t, remote, "worktree", "add", "-q", "-b", "feature", added)
+	expectChange(t, w, remote)
+
+	item := findItem(t, rm, remote)
+	if len(item.SubItems) != 2 {
+		t.Fatalf("expected 2 worktrees after adding one, got %d", len(item.SubItems))
+	}
+
+	writeFile(t, filepath.Join(added, "new.txt"), "new\n")
+	expectChange(t, w, added)
+}
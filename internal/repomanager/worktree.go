@@ -0,0 +1,273 @@
+package repomanager
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+// ErrWorktreeDirty is returned when removing a worktree with uncommitted or untracked changes without force.
+var ErrWorktreeDirty = errors.New("worktree has uncommitted or untracked changes")
+
+// NewWorktree describes a worktree to create for a repository.
+type NewWorktree struct {
+	Path   string // Directory the worktree is created in
+	Branch Branch // Branch to check out, or the start point when NewBranch is set
+	// NewBranch is the name of a branch created at Branch for the worktree. Empty
+	// checks out Branch itself; remote-tracking branches always get a local branch.
+	NewBranch string
+}
+
+// LocalBranch returns the name of the branch the worktree will have checked out.
+// A remote-tracking branch is checked out as a local branch of the same name.
+func (wt NewWorktree) LocalBranch() string {
+	if wt.NewBranch != "" {
+		return wt.NewBranch
+	}
+	if wt.Branch.Remote {
+		if _, name, ok := strings.Cut(wt.Branch.Name, "/"); ok {
+			return name
+		}
+	}
+	return wt.Branch.Name
+}
+
+// WorktreePath proposes a path for a worktree of the repository at repoPath with
+// the given branch checked out, filling in the placeholders of a path template.
+// Relative results are placed relative to the repository.
+func WorktreePath(template, repoPath, branch string) string {
+	if template == "" {
+		template = config.DefaultWorktreePathTemplate
+	}
+
+	repoPath = filepath.Clean(repoPath)
+	path := strings.NewReplacer(
+		"{repo}", repoPath,
+		"{parent}", filepath.Dir(repoPath),
+		"{name}", strings.TrimSuffix(extractNameFromPath(repoPath), ".git"),
+		"{branch}", branch,
+	).Replace(template)
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(repoPath, path)
+	}
+	return filepath.Clean(path)
+}
+
+// AddWorktree runs git worktree add for the tracked repository at repoPath and
+// adds the new worktree to its sub-items right away, so it shows up without
+// waiting for the next worktree reload.
+func (rm *RepoManager) AddWorktree(repoPath string, wt NewWorktree) (*SubItem, error) {
+	var item *RepoItem
+	for _, candidate := range rm.sharedItems() {
+		if candidate.Path == repoPath {
+			item = candidate
+			break
+		}
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%s is not a tracked repository", repoPath)
+	}
+	if wt.Path == "" {
+		return nil, errors.New("no worktree path given")
+	}
+
+	args := []string{"worktree", "add"}
+	switch {
+	case wt.NewBranch != "":
+		args = append(args, "-b", wt.NewBranch, wt.Path, wt.Branch.Name)
+	case wt.Branch.Remote:
+		args = append(args, "--track", "-b", wt.LocalBranch(), wt.Path, wt.Branch.Name)
+	default:
+		args = append(args, wt.Path, wt.Branch.Name)
+	}
+	if _, err := rm.runGitCommand(repoPath, args...); err != nil {
+		return nil, err
+	}
+
+	path, err := filepath.Abs(wt.Path)
+	if err != nil {
+		path = wt.Path
+	}
+	subItem := &SubItem{
+		Name:       extractNameFromPath(path),
+		Path:       path,
+		Branch:     wt.LocalBranch(),
+		ParentRepo: item,
+	}
+	rm.updateSubItemStatus(subItem)
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	// A worktree reload running meanwhile may already have listed the new worktree
+	for _, existing := range item.SubItems {
+		if existing.Path == path {
+			return existing, nil
+		}
+	}
+	item.SubItems = append(item.SubItems, subItem)
+	return subItem, nil
+}
+
+// applyWorktreeInfo copies the lock and prune state listed by git worktree list.
+func (subItem *SubItem) applyWorktreeInfo(wt WorktreeInfo) {
+	subItem.Locked = wt.Locked
+	subItem.LockReason = wt.LockReason
+	subItem.Prunable = wt.Prunable
+	subItem.PruneReason = wt.PruneReason
+}
+
+// RemoveWorktree removes the linked worktree at path together with its directory
+// and drops it from the sub-items of its repository. Without force it refuses
+// when the worktree has uncommitted or untracked changes, since those would be
+// lost. Locked worktrees must be unlocked first.
+func (rm *RepoManager) RemoveWorktree(path string, force bool) error {
+	item, subItem := rm.findWorktree(path)
+	if subItem == nil {
+		return fmt.Errorf("%s is not a tracked worktree", path)
+	}
+
+	rm.mu.RLock()
+	locked, prunable := subItem.Locked, subItem.Prunable
+	rm.mu.RUnlock()
+
+	if locked {
+		return errors.New("worktree is locked, unlock it first")
+	}
+	// The directory of a prunable worktree may be gone, so there is nothing to check
+	if !force && !prunable {
+		status, err := rm.readStatus(path)
+		if err != nil {
+			return err
+		}
+		if status.Changed > 0 || status.Untracked > 0 {
+			return ErrWorktreeDirty
+		}
+	}
+
+	args := []string{"worktree", "remove", path}
+	if force {
+		args = []string{"worktree", "remove", "--force", path}
+	}
+	if _, err := rm.runGitCommand(item.Path, args...); err != nil {
+		return err
+	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	subItems := make([]*SubItem, 0, len(item.SubItems))
+	for _, existing := range item.SubItems {
+		if existing != subItem {
+			subItems = append(subItems, existing)
+		}
+	}
+	item.SubItems = subItems
+	return nil
+}
+
+// PruneWorktrees removes the administrative files of worktrees whose directory is
+// gone and reloads the worktrees of the repository. Locked worktrees are kept.
+func (rm *RepoManager) PruneWorktrees(repoPath string) error {
+	for _, item := range rm.sharedItems() {
+		if item.Path == repoPath {
+			if _, err := rm.runGitCommand(repoPath, "worktree", "prune"); err != nil {
+				return err
+			}
+			rm.loadWorktrees(item)
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not a tracked repository", repoPath)
+}
+
+// LockWorktree locks the worktree at path so it is not pruned, moved or removed.
+// The reason is optional and shown next to the lock.
+func (rm *RepoManager) LockWorktree(path, reason string) error {
+	item, subItem := rm.findWorktree(path)
+	if subItem == nil {
+		return fmt.Errorf("%s is not a tracked worktree", path)
+	}
+
+	args := []string{"worktree", "lock", path}
+	if reason != "" {
+		args = []string{"worktree", "lock", "--reason", reason, path}
+	}
+	if _, err := rm.runGitCommand(item.Path, args...); err != nil {
+		return err
+	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	subItem.Locked = true
+	subItem.LockReason = reason
+	return nil
+}
+
+// UnlockWorktree unlocks the worktree at path.
+func (rm *RepoManager) UnlockWorktree(path string) error {
+	item, subItem := rm.findWorktree(path)
+	if subItem == nil {
+		return fmt.Errorf("%s is not a tracked worktree", path)
+	}
+
+	if _, err := rm.runGitCommand(item.Path, "worktree", "unlock", path); err != nil {
+		return err
+	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	subItem.Locked = false
+	subItem.LockReason = ""
+	return nil
+}
+
+// findWorktree returns the worktree sub-item at path and the repository it belongs to.
+func (rm *RepoManager) findWorktree(path string) (*RepoItem, *SubItem) {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	for _, item := range rm.items {
+		for _, subItem := range item.SubItems {
+			if subItem.Path == path {
+				return item, subItem
+			}
+		}
+	}
+	return nil, nil
+}
+
+// trackedPaths returns the canonical paths of all tracked repositories.
+func (rm *RepoManager) trackedPaths() map[string]bool {
+	items := rm.sharedItems()
+	paths := make(map[string]bool, len(items))
+	for _, item := range items {
+		paths[item.canonicalPath()] = true
+	}
+	return paths
+}
+
+// canonicalPath returns the canonical path of the item, resolving it only for
+// items not created through newRepoItem.
+func (item *RepoItem) canonicalPath() string {
+	if item.canonical != "" {
+		return item.canonical
+	}
+	return canonicalPath(item.Path)
+}
+
+// canonicalPath returns the absolute path with symbolic links resolved, the form
+// git worktree list prints, so configured paths compare equal to listed ones.
+func canonicalPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	return filepath.Clean(path)
+}
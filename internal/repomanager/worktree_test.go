@@ -0,0 +1,258 @@
+package repomanager
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+)
+
+func TestWorktreePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "default", want: "/code/app.git/feature/login"},
+		{name: "sibling", template: "{parent}/{name}-{branch}", want: "/code/app-feature/login"},
+		{name: "relative", template: "../trees/{branch}", want: "/code/trees/feature/login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WorktreePath(tt.template, "/code/app.git/", "feature/login"); got != tt.want {
+				t.Errorf("WorktreePath = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddWorktree(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+	git(t, remote, "branch", "feature", "main")
+
+	bare := filepath.Join(root, "app.git")
+	git(t, root, "clone", "-q", "--bare", remote, bare)
+	git(t, bare, "config", "remote.origin.fetch", "+refs/heads/*:refs/remotes/origin/*")
+	git(t, bare, "fetch", "-q", "origin")
+	git(t, bare, "branch", "-q", "-D", "feature")
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{bare}})
+	item := findItem(t, rm, bare)
+
+	main := Branch{Name: "main"}
+	worktrees := []struct {
+		wt         NewWorktree
+		wantBranch string
+	}{
+		{wt: NewWorktree{Path: WorktreePath("", bare, "main"), Branch: main}, wantBranch: "main"},
+		{wt: NewWorktree{Path: WorktreePath("", bare, "topic"), Branch: main, NewBranch: "topic"}, wantBranch: "topic"},
+		{wt: NewWorktree{Path: WorktreePath("", bare, "feature"), Branch: Branch{Name: "origin/feature", Remote: true}}, wantBranch: "feature"},
+	}
+	var added []*SubItem
+	for _, tt := range worktrees {
+		subItem, err := rm.AddWorktree(bare, tt.wt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if subItem.Path != tt.wt.Path || subItem.Branch != tt.wantBranch || subItem.HasError {
+			t.Errorf("unexpected sub-item %+v", subItem)
+		}
+		if got := git(t, subItem.Path, "branch", "--show-current"); got != tt.wantBranch {
+			t.Errorf("checked out %q, want %q", got, tt.wantBranch)
+		}
+		added = append(added, subItem)
+	}
+
+	if len(item.SubItems) != 3 {
+		t.Fatalf("got %d sub-items, want 3", len(item.SubItems))
+	}
+	if got := git(t, bare, "rev-parse", "--abbrev-ref", "feature@{upstream}"); got != "origin/feature" {
+		t.Errorf("upstream of feature = %q", got)
+	}
+
+	// A branch can only be checked out in one worktree
+	if _, err := rm.AddWorktree(bare, NewWorktree{Path: filepath.Join(root, "again"), Branch: main}); err == nil {
+		t.Error("expected adding a second worktree for main to fail")
+	}
+	if len(item.SubItems) != 3 {
+		t.Errorf("a failed add changed the sub-items: %d", len(item.SubItems))
+	}
+
+	// Reloading worktrees keeps the inserted sub-items
+	rm.ReloadWorktrees()
+	if len(item.SubItems) != 3 {
+		t.Fatalf("got %d sub-items after reload, want 3", len(item.SubItems))
+	}
+	for _, subItem := range added {
+		found := false
+		for _, reloaded := range item.SubItems {
+			found = found || reloaded == subItem
+		}
+		if !found {
+			t.Errorf("reload replaced the sub-item of %s", subItem.Path)
+		}
+	}
+}
+
+func TestParseWorktreeList(t *testing.T) {
+	output := `worktree /code/app.git
+bare
+
+worktree /code/app.git/main
+HEAD 1111111111111111111111111111111111111111
+branch refs/heads/main
+locked
+
+worktree /code/app.git/review
+HEAD 2222222222222222222222222222222222222222
+detached
+locked on a usb drive
+
+worktree /code/app.git/old
+HEAD 3333333333333333333333333333333333333333
+branch refs/heads/old
+prunable gitdir file points to non-existent location
+`
+
+	rm := &RepoManager{}
+	got, err := rm.parseWorktreeList(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []WorktreeInfo{
+		{Path: "/code/app.git", Bare: true},
+		{Path: "/code/app.git/main", Branch: "main", Locked: true},
+		{Path: "/code/app.git/review", Detached: true, Locked: true, LockReason: "on a usb drive"},
+		{Path: "/code/app.git/old", Branch: "old", Prunable: true, PruneReason: "gitdir file points to non-existent location"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d worktrees, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("worktree %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWorktreeManagement(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	bare := filepath.Join(root, "app.git")
+	git(t, root, "clone", "-q", "--bare", remote, bare)
+	for _, branch := range []string{"one", "two", "three"} {
+		git(t, bare, "worktree", "add", "-q", "-b", branch, filepath.Join(bare, branch), "main")
+	}
+	git(t, bare, "worktree", "lock", "--reason", "in use", filepath.Join(bare, "one"))
+	if err := os.RemoveAll(filepath.Join(bare, "three")); err != nil {
+		t.Fatal(err)
+	}
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{bare}})
+	item := findItem(t, rm, bare)
+	one, two, three := item.SubItems[0], item.SubItems[2], item.SubItems[1]
+	if !one.Locked || one.LockReason != "in use" || !three.Prunable || two.Locked || two.Prunable {
+		t.Fatalf("unexpected worktree states: one=%+v three=%+v", one, three)
+	}
+
+	// Locked worktrees are neither removed nor pruned
+	if err := rm.RemoveWorktree(one.Path, true); err == nil {
+		t.Error("expected removing a locked worktree to fail")
+	}
+	if err := rm.UnlockWorktree(one.Path); err != nil || one.Locked {
+		t.Fatalf("unlock: %v", err)
+	}
+	if err := rm.LockWorktree(two.Path, ""); err != nil || !two.Locked {
+		t.Fatalf("lock: %v", err)
+	}
+	if err := rm.UnlockWorktree(two.Path); err != nil {
+		t.Fatal(err)
+	}
+
+	commitFile(t, one.Path, "wip.txt", "wip\n")
+	if err := os.WriteFile(filepath.Join(one.Path, "scratch.txt"), []byte("scratch\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.RemoveWorktree(one.Path, false); !errors.Is(err, ErrWorktreeDirty) {
+		t.Fatalf("expected a dirty worktree to be refused, got %v", err)
+	}
+	if err := rm.RemoveWorktree(one.Path, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(one.Path); !os.IsNotExist(err) {
+		t.Error("expected the worktree directory to be removed")
+	}
+	if err := rm.RemoveWorktree(two.Path, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rm.PruneWorktrees(bare); err != nil {
+		t.Fatal(err)
+	}
+	if len(item.SubItems) != 0 {
+		t.Errorf("expected no worktrees left, got %d", len(item.SubItems))
+	}
+	if got := git(t, bare, "worktree", "list", "--porcelain"); strings.Contains(got, "three") {
+		t.Errorf("pruned worktree still listed:\n%s", got)
+	}
+}
+
+func TestLoadWorktrees_NonBare(t *testing.T) {
+	isolateGit(t)
+	root := t.TempDir()
+	remote := setupRemote(t, root)
+
+	work := filepath.Join(root, "work")
+	feature := filepath.Join(root, "work-feature")
+	git(t, root, "clone", "-q", remote, work)
+	git(t, work, "worktree", "add", "-q", "-b", "feature", feature)
+
+	// The clone is tracked through a symbolic link, so paths differ from git's
+	link := filepath.Join(root, "link")
+	if err := os.Symlink(work, link); err != nil {
+		t.Fatal(err)
+	}
+
+	rm := newTestManager(t, &config.Config{RepositoryPaths: []string{link}})
+	item := findItem(t, rm, link)
+	if len(item.SubItems) != 1 || item.SubItems[0].Path != feature || item.SubItems[0].Branch != "feature" {
+		t.Fatalf("expected only the linked worktree as sub-item, got %d", len(item.SubItems))
+	}
+
+	// A linked worktree tracked on its own is no longer listed under its repository
+	if err := rm.AddRepo(feature); err != nil {
+		t.Fatal(err)
+	}
+	rm.ReloadWorktrees()
+	if len(item.SubItems) != 0 {
+		t.Errorf("expected the standalone worktree to be left out, got %d sub-items", len(item.SubItems))
+	}
+	standalone := findItem(t, rm, feature)
+	if !standalone.IsWorktree || len(standalone.SubItems) != 0 {
+		t.Errorf("expected a linked worktree without sub-items, got %+v", standalone)
+	}
+
+	if err := rm.RemoveRepo(feature); err != nil {
+		t.Fatal(err)
+	}
+	rm.ReloadWorktrees()
+	if len(item.SubItems) != 1 {
+		t.Errorf("expected the worktree back under its repository, got %d sub-items", len(item.SubItems))
+	}
+
+	// A status refresh picks up worktrees added outside the dashboard
+	git(t, work, "worktree", "add", "-q", "-b", "other", filepath.Join(root, "work-other"))
+	rm.ReloadStatus()
+	if len(item.SubItems) != 2 {
+		t.Errorf("expected the new worktree after a status refresh, got %d sub-items", len(item.SubItems))
+	}
+}
@@ -10,35 +10,58 @@ type Theme struct {
 
 // Colors defines all color values used in the UI.
 type Colors struct {
-	Title              string `yaml:"title"`
-	TitleBackground    string `yaml:"title_background"`
-	Selected           string `yaml:"selected"`
-	SelectedBackground string `yaml:"selected_background"`
-	StatusClean        string `yaml:"status_clean"`
-	StatusDirty        string `yaml:"status_dirty"`
-	StatusUnpushed     string `yaml:"status_unpushed"`
-	StatusUntracked    string `yaml:"status_untracked"`
-	StatusError        string `yaml:"status_error"`
-	StatusNotAdded     string `yaml:"status_not_added"`
-	Help               string `yaml:"help"`
-	Border             string `yaml:"border"`
-	ModalBackground    string `yaml:"modal_background"`
-	Branch             string `yaml:"branch"`
-	IconRegular        string `yaml:"icon_regular"`
-	IconBare           string `yaml:"icon_bare"`
-	IconWorktree       string `yaml:"icon_worktree"`
+	Title               string `yaml:"title"`
+	TitleBackground     string `yaml:"title_background"`
+	Selected            string `yaml:"selected"`
+	SelectedBackground  string `yaml:"selected_background"`
+	StatusClean         string `yaml:"status_clean"`
+	StatusDirty         string `yaml:"status_dirty"`
+	StatusUnpushed      string `yaml:"status_unpushed"`
+	StatusBehind        string `yaml:"status_behind"`
+	StatusStale         string `yaml:"status_stale"`
+	StatusStash         string `yaml:"status_stash"`
+	StatusMerging       string `yaml:"status_merging"`
+	StatusRebasing      string `yaml:"status_rebasing"`
+	StatusCherryPicking string `yaml:"status_cherry_picking"`
+	StatusReverting     string `yaml:"status_reverting"`
+	StatusBisecting     string `yaml:"status_bisecting"`
+	StatusUntracked     string `yaml:"status_untracked"`
+	StatusError         string `yaml:"status_error"`
+	StatusNotAdded      string `yaml:"status_not_added"`
+	Help                string `yaml:"help"`
+	Border              string `yaml:"border"`
+	ModalBackground     string `yaml:"modal_background"`
+	Branch              string `yaml:"branch"`
+	IconRegular         string `yaml:"icon_regular"`
+	IconBare            string `yaml:"icon_bare"`
+	IconWorktree        string `yaml:"icon_worktree"`
+	DiffAdded           string `yaml:"diff_added"`
+	DiffRemoved         string `yaml:"diff_removed"`
+	DiffHunk            string `yaml:"diff_hunk"`
+	DiffHeader          string `yaml:"diff_header"`
 }
 
 // Indicators defines all status indicator symbols.
 type Indicators struct {
-	Clean       string `yaml:"clean"`
-	Dirty       string `yaml:"dirty"`
-	Unpushed    string `yaml:"unpushed"`
-	Untracked   string `yaml:"untracked"`
-	Error       string `yaml:"error"`
-	NotAdded    string `yaml:"not_added"`
-	Selected    string `yaml:"selected"`
-	SelectedEnd string `yaml:"selected_end"`
+	Clean         string `yaml:"clean"`
+	Dirty         string `yaml:"dirty"`
+	Unpushed      string `yaml:"unpushed"`
+	Behind        string `yaml:"behind"`
+	Stale         string `yaml:"stale"`
+	Stash         string `yaml:"stash"`
+	Locked        string `yaml:"locked"`
+	Prunable      string `yaml:"prunable"`
+	Merging       string `yaml:"merging"`
+	Rebasing      string `yaml:"rebasing"`
+	CherryPicking string `yaml:"cherry_picking"`
+	Reverting     string `yaml:"reverting"`
+	Bisecting     string `yaml:"bisecting"`
+	Untracked     string `yaml:"untracked"`
+	Error         string `yaml:"error"`
+	NotAdded      string `yaml:"not_added"`
+	Selected      string `yaml:"selected"`
+	SelectedEnd   string `yaml:"selected_end"`
+	Marked        string `yaml:"marked"`
 }
 
 // Icons defines all icon symbols used in the UI.
@@ -66,33 +89,56 @@ type Icons struct {
 func Default() Theme {
 	return Theme{
 		Colors: Colors{
-			Title:              "#FAFAFA",
-			TitleBackground:    "#7D56F4",
-			Selected:           "#7D56F4",
-			SelectedBackground: "#7D56F4FF",
-			StatusClean:        "#6BCF7F",
-			StatusDirty:        "#FF6B6B",
-			StatusUnpushed:     "#FFD93D",
-			StatusUntracked:    "#FFA500",
-			StatusError:        "#FF0000",
-			StatusNotAdded:     "#626262",
-			Help:               "#626262",
-			Border:             "#7D56F4",
-			ModalBackground:    "#1E1E1E",
-			Branch:             "#00D4AA",
-			IconRegular:        "#4A9EFF",
-			IconBare:           "#FFA500",
-			IconWorktree:       "#32CD32",
+			Title:               "#FAFAFA",
+			TitleBackground:     "#7D56F4",
+			Selected:            "#7D56F4",
+			SelectedBackground:  "#7D56F4FF",
+			StatusClean:         "#6BCF7F",
+			StatusDirty:         "#FF6B6B",
+			StatusUnpushed:      "#FFD93D",
+			StatusBehind:        "#4FC1FF",
+			StatusStale:         "#A0A0A0",
+			StatusStash:         "#C792EA",
+			StatusMerging:       "#FF79C6",
+			StatusRebasing:      "#FF8C42",
+			StatusCherryPicking: "#E0457B",
+			StatusReverting:     "#FFB86C",
+			StatusBisecting:     "#8BE9FD",
+			StatusUntracked:     "#FFA500",
+			StatusError:         "#FF0000",
+			StatusNotAdded:      "#626262",
+			Help:                "#626262",
+			Border:              "#7D56F4",
+			ModalBackground:     "#1E1E1E",
+			Branch:              "#00D4AA",
+			IconRegular:         "#4A9EFF",
+			IconBare:            "#FFA500",
+			IconWorktree:        "#32CD32",
+			DiffAdded:           "#6BCF7F",
+			DiffRemoved:         "#FF6B6B",
+			DiffHunk:            "#4FC1FF",
+			DiffHeader:          "#C792EA",
 		},
 		Indicators: Indicators{
-			Clean:       "󰄬 ",
-			Dirty:       "󰏫 ",
-			Unpushed:    "󰕒 ",
-			Untracked:   "󰈔 ",
-			Error:       " ",
-			NotAdded:    "󰝒 ",
-			Selected:    "󰒊 ",
-			SelectedEnd: "▌",
+			Clean:         "󰄬 ",
+			Dirty:         "󰏫 ",
+			Unpushed:      "󰕒 ",
+			Behind:        "󰇚 ",
+			Stale:         "󰔟 ",
+			Stash:         "󰏗 ",
+			Locked:        "󰌾 ",
+			Prunable:      "󰃢 ",
+			Merging:       "󰘭 ",
+			Rebasing:      "󰓦 ",
+			CherryPicking: "󱁂 ",
+			Reverting:     "󰕌 ",
+			Bisecting:     "󰍉 ",
+			Untracked:     "󰈔 ",
+			Error:         " ",
+			NotAdded:      "󰝒 ",
+			Selected:      "󰒊 ",
+			SelectedEnd:   "▌",
+			Marked:        "󰄲 ",
 		},
 		Icons: Icons{
 			Repository: struct {
@@ -153,6 +199,30 @@ func MergeWithDefault(userTheme Theme) Theme {
 	if userTheme.Colors.StatusUnpushed == "" {
 		userTheme.Colors.StatusUnpushed = defaultTheme.Colors.StatusUnpushed
 	}
+	if userTheme.Colors.StatusBehind == "" {
+		userTheme.Colors.StatusBehind = defaultTheme.Colors.StatusBehind
+	}
+	if userTheme.Colors.StatusStale == "" {
+		userTheme.Colors.StatusStale = defaultTheme.Colors.StatusStale
+	}
+	if userTheme.Colors.StatusStash == "" {
+		userTheme.Colors.StatusStash = defaultTheme.Colors.StatusStash
+	}
+	if userTheme.Colors.StatusMerging == "" {
+		userTheme.Colors.StatusMerging = defaultTheme.Colors.StatusMerging
+	}
+	if userTheme.Colors.StatusRebasing == "" {
+		userTheme.Colors.StatusRebasing = defaultTheme.Colors.StatusRebasing
+	}
+	if userTheme.Colors.StatusCherryPicking == "" {
+		userTheme.Colors.StatusCherryPicking = defaultTheme.Colors.StatusCherryPicking
+	}
+	if userTheme.Colors.StatusReverting == "" {
+		userTheme.Colors.StatusReverting = defaultTheme.Colors.StatusReverting
+	}
+	if userTheme.Colors.StatusBisecting == "" {
+		userTheme.Colors.StatusBisecting = defaultTheme.Colors.StatusBisecting
+	}
 	if userTheme.Colors.StatusUntracked == "" {
 		userTheme.Colors.StatusUntracked = defaultTheme.Colors.StatusUntracked
 	}
@@ -183,6 +253,18 @@ func MergeWithDefault(userTheme Theme) Theme {
 	if userTheme.Colors.IconWorktree == "" {
 		userTheme.Colors.IconWorktree = defaultTheme.Colors.IconWorktree
 	}
+	if userTheme.Colors.DiffAdded == "" {
+		userTheme.Colors.DiffAdded = defaultTheme.Colors.DiffAdded
+	}
+	if userTheme.Colors.DiffRemoved == "" {
+		userTheme.Colors.DiffRemoved = defaultTheme.Colors.DiffRemoved
+	}
+	if userTheme.Colors.DiffHunk == "" {
+		userTheme.Colors.DiffHunk = defaultTheme.Colors.DiffHunk
+	}
+	if userTheme.Colors.DiffHeader == "" {
+		userTheme.Colors.DiffHeader = defaultTheme.Colors.DiffHeader
+	}
 
 	// Merge indicators
 	if userTheme.Indicators.Clean == "" {
@@ -194,6 +276,36 @@ func MergeWithDefault(userTheme Theme) Theme {
 	if userTheme.Indicators.Unpushed == "" {
 		userTheme.Indicators.Unpushed = defaultTheme.Indicators.Unpushed
 	}
+	if userTheme.Indicators.Behind == "" {
+		userTheme.Indicators.Behind = defaultTheme.Indicators.Behind
+	}
+	if userTheme.Indicators.Stale == "" {
+		userTheme.Indicators.Stale = defaultTheme.Indicators.Stale
+	}
+	if userTheme.Indicators.Stash == "" {
+		userTheme.Indicators.Stash = defaultTheme.Indicators.Stash
+	}
+	if userTheme.Indicators.Locked == "" {
+		userTheme.Indicators.Locked = defaultTheme.Indicators.Locked
+	}
+	if userTheme.Indicators.Prunable == "" {
+		userTheme.Indicators.Prunable = defaultTheme.Indicators.Prunable
+	}
+	if userTheme.Indicators.Merging == "" {
+		userTheme.Indicators.Merging = defaultTheme.Indicators.Merging
+	}
+	if userTheme.Indicators.Rebasing == "" {
+		userTheme.Indicators.Rebasing = defaultTheme.Indicators.Rebasing
+	}
+	if userTheme.Indicators.CherryPicking == "" {
+		userTheme.Indicators.CherryPicking = defaultTheme.Indicators.CherryPicking
+	}
+	if userTheme.Indicators.Reverting == "" {
+		userTheme.Indicators.Reverting = defaultTheme.Indicators.Reverting
+	}
+	if userTheme.Indicators.Bisecting == "" {
+		userTheme.Indicators.Bisecting = defaultTheme.Indicators.Bisecting
+	}
 	if userTheme.Indicators.Untracked == "" {
 		userTheme.Indicators.Untracked = defaultTheme.Indicators.Untracked
 	}
@@ -209,6 +321,9 @@ func MergeWithDefault(userTheme Theme) Theme {
 	if userTheme.Indicators.SelectedEnd == "" {
 		userTheme.Indicators.SelectedEnd = defaultTheme.Indicators.SelectedEnd
 	}
+	if userTheme.Indicators.Marked == "" {
+		userTheme.Indicators.Marked = defaultTheme.Indicators.Marked
+	}
 
 	// Merge icons
 	if userTheme.Icons.Repository.Regular == "" {
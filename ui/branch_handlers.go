@@ -0,0 +1,262 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/branches"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// Branch name inputs of the branch page.
+const (
+	branchInputCreate = "create"
+	branchInputRename = "rename"
+)
+
+// BranchesLoaded carries the branches of the item shown on the branch page.
+type BranchesLoaded struct {
+	Path     string
+	Branches []repomanager.Branch
+	Err      error
+}
+
+// BranchActionComplete indicates that a checkout, create, rename or delete finished.
+type BranchActionComplete struct {
+	Path   string
+	Action string // "checkout", "create", "rename" or "delete"
+	Result string // Description of the change, shown when it succeeded
+	Err    error
+}
+
+// openBranches switches to the branch page of an item and loads its branches.
+func (m Model) openBranches(item types.NavigableItem) (Model, tea.Cmd) {
+	m.BranchReturnState = m.State
+	m.State = BranchView
+	m.SelectedNavItem = &item
+	m.Branches = nil
+	m.BranchCursor = 0
+	m.BranchOffset = 0
+	m.BranchLoading = true
+	m.BranchStatus = ""
+	m.BranchInputAction = ""
+	m.BranchInput = ""
+	m.BranchDeleteConfirm = false
+	return m, m.loadBranches(item.Path())
+}
+
+// closeBranches returns to the view the branch page was opened from.
+func (m Model) closeBranches() Model {
+	m.State = m.BranchReturnState
+	if m.State == ListView {
+		m.SelectedNavItem = nil
+	}
+	return m
+}
+
+// loadBranches reads the branches of the repository at path.
+func (m Model) loadBranches(path string) tea.Cmd {
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return func() tea.Msg {
+		list, err := repoManager.ListBranches(path)
+		return BranchesLoaded{Path: path, Branches: list, Err: err}
+	}
+}
+
+// handleBranchesLoaded stores the branches if the branch page still shows the same item.
+func (m Model) handleBranchesLoaded(msg BranchesLoaded) (tea.Model, tea.Cmd) {
+	if m.State != BranchView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	m.BranchLoading = false
+	if msg.Err != nil {
+		m.BranchStatus = "Failed to list branches: " + msg.Err.Error()
+		return m, nil
+	}
+	m.Branches = msg.Branches
+	return m.moveBranchCursor(0), nil
+}
+
+// moveBranchCursor moves the selection by delta branches and keeps it in view.
+func (m Model) moveBranchCursor(delta int) Model {
+	m.BranchCursor, m.BranchOffset = scrollWindow(m.BranchCursor, m.BranchOffset, delta, len(m.Branches), branches.VisibleRows(m.Height))
+	return m
+}
+
+// selectedBranch returns the branch under the cursor.
+func (m Model) selectedBranch() (repomanager.Branch, bool) {
+	if m.SelectedNavItem == nil || m.BranchCursor >= len(m.Branches) {
+		return repomanager.Branch{}, false
+	}
+	return m.Branches[m.BranchCursor], true
+}
+
+// checkoutBranch checks out the selected branch in the item's working tree.
+func (m Model) checkoutBranch() (Model, tea.Cmd) {
+	branch, ok := m.selectedBranch()
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case !hasWorkingTree(*m.SelectedNavItem):
+		m.BranchStatus = "A bare repository has no working tree to check out into"
+		return m, nil
+	case branch.Head:
+		m.BranchStatus = branch.Name + " is already checked out"
+		return m, nil
+	case branch.Worktree != "":
+		m.BranchStatus = fmt.Sprintf("%s is checked out in %s", branch.Name, branch.Worktree)
+		return m, nil
+	}
+
+	return m.runBranchAction("checkout", "Checked out "+branch.Name, func(repoManager *repomanager.RepoManager, path string) error {
+		return repoManager.CheckoutBranch(path, branch)
+	})
+}
+
+// startBranchInput starts typing the name of a new branch or the new name of the
+// selected one. Remote-tracking branches cannot be renamed.
+func (m Model) startBranchInput(action string) Model {
+	branch, ok := m.selectedBranch()
+	if !ok {
+		return m
+	}
+
+	m.BranchStatus = ""
+	switch action {
+	case branchInputCreate:
+		m.BranchInput = ""
+	case branchInputRename:
+		if branch.Remote {
+			m.BranchStatus = "Remote-tracking branches cannot be renamed"
+			return m
+		}
+		m.BranchInput = branch.Name
+	}
+	m.BranchInputAction = action
+	return m
+}
+
+// editBranchInput applies a key press to the branch name being typed. Branch
+// names cannot contain spaces, so those are ignored.
+func (m Model) editBranchInput(msg tea.KeyMsg) Model {
+	if msg.Alt {
+		return m
+	}
+
+	switch msg.Type {
+	case tea.KeyRunes:
+		m.BranchInput += string(msg.Runes)
+	case tea.KeyBackspace:
+		if runes := []rune(m.BranchInput); len(runes) > 0 {
+			m.BranchInput = string(runes[:len(runes)-1])
+		}
+	}
+	return m
+}
+
+// cancelBranchInput stops typing a branch name without changing anything.
+func (m Model) cancelBranchInput() Model {
+	m.BranchInputAction = ""
+	m.BranchInput = ""
+	return m
+}
+
+// submitBranchInput creates or renames a branch using the typed name.
+func (m Model) submitBranchInput() (Model, tea.Cmd) {
+	branch, ok := m.selectedBranch()
+	name := strings.TrimSpace(m.BranchInput)
+	if !ok || name == "" {
+		return m, nil
+	}
+
+	action := m.BranchInputAction
+	m = m.cancelBranchInput()
+
+	switch action {
+	case branchInputCreate:
+		return m.runBranchAction("create", fmt.Sprintf("Created %s from %s", name, branch.Name), func(repoManager *repomanager.RepoManager, path string) error {
+			return repoManager.CreateBranch(path, name, branch)
+		})
+	case branchInputRename:
+		return m.runBranchAction("rename", fmt.Sprintf("Renamed %s to %s", branch.Name, name), func(repoManager *repomanager.RepoManager, path string) error {
+			return repoManager.RenameBranch(path, branch, name)
+		})
+	}
+	return m, nil
+}
+
+// confirmBranchDelete asks for confirmation before deleting the selected branch,
+// since deleting cannot be undone, warning when the branch has commits that would
+// be lost.
+func (m Model) confirmBranchDelete() Model {
+	branch, ok := m.selectedBranch()
+	if !ok {
+		return m
+	}
+
+	switch {
+	case branch.Remote:
+		m.BranchStatus = "Remote-tracking branches are not deleted from here"
+		return m
+	case branch.Worktree != "":
+		m.BranchStatus = fmt.Sprintf("%s is checked out in %s", branch.Name, branch.Worktree)
+		return m
+	case branch.Merged:
+		m.BranchStatus = fmt.Sprintf("Delete %s? Press y to confirm, any other key to cancel", branch.Name)
+	default:
+		m.BranchStatus = fmt.Sprintf("%s is not merged, its commits will be lost. Press y to force delete, any other key to cancel", branch.Name)
+	}
+	m.BranchDeleteConfirm = true
+	return m
+}
+
+// handleBranchDeleteConfirm deletes the selected branch when the key is y. An
+// unmerged branch was confirmed with its warning, so it is force deleted.
+func (m Model) handleBranchDeleteConfirm(keyStr string) (Model, tea.Cmd) {
+	m.BranchDeleteConfirm = false
+	m.BranchStatus = ""
+
+	branch, ok := m.selectedBranch()
+	if keyStr != "y" || !ok {
+		return m, nil
+	}
+
+	return m.runBranchAction("delete", "Deleted "+branch.Name, func(repoManager *repomanager.RepoManager, path string) error {
+		return repoManager.DeleteBranch(path, branch, !branch.Merged)
+	})
+}
+
+// runBranchAction runs a branch action on the selected item in the background.
+func (m Model) runBranchAction(action, result string, op func(repoManager *repomanager.RepoManager, path string) error) (Model, tea.Cmd) {
+	path := m.SelectedNavItem.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.BranchStatus = fmt.Sprintf("Running %s...", action)
+	return m, func() tea.Msg {
+		err := op(repoManager, path)
+		return BranchActionComplete{Path: path, Action: action, Result: result, Err: err}
+	}
+}
+
+// handleBranchActionComplete reports the outcome of a branch action, reloads the
+// branches and refreshes statuses, since the checked out branch may have changed.
+func (m Model) handleBranchActionComplete(msg BranchActionComplete) (tea.Model, tea.Cmd) {
+	if m.SelectedNavItem != nil && m.SelectedNavItem.Path() == msg.Path {
+		if msg.Err != nil {
+			logging.Get().Error("branch action failed", "action", msg.Action, "path", msg.Path, "error", msg.Err)
+			m.BranchStatus = fmt.Sprintf("Branch %s failed: %v", msg.Action, msg.Err)
+		} else {
+			m.BranchStatus = msg.Result
+		}
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, tea.Batch(m.loadBranches(msg.Path), refresh)
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// newBranchModel returns a model showing the branch page of a repository.
+func newBranchModel(list ...repomanager.Branch) Model {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/repo"}}
+	return Model{
+		Dependencies:    stubDependencies{},
+		State:           BranchView,
+		SelectedNavItem: &item,
+		Branches:        list,
+	}
+}
+
+func TestConfirmBranchDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		branch      repomanager.Branch
+		wantConfirm bool
+		wantStatus  string
+	}{
+		{"merged", repomanager.Branch{Name: "done", Merged: true}, true, "Delete done?"},
+		{"unmerged", repomanager.Branch{Name: "wip"}, true, "wip is not merged"},
+		{"remote", repomanager.Branch{Name: "origin/main", Remote: true}, false, "Remote-tracking branches"},
+		{"checked out", repomanager.Branch{Name: "main", Head: true, Worktree: "/repo"}, false, "main is checked out in /repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newBranchModel(tt.branch).confirmBranchDelete()
+			if m.BranchDeleteConfirm != tt.wantConfirm || !strings.HasPrefix(m.BranchStatus, tt.wantStatus) {
+				t.Fatalf("confirm = %v, status = %q", m.BranchDeleteConfirm, m.BranchStatus)
+			}
+			if !tt.wantConfirm {
+				return
+			}
+
+			if cancelled, cmd := m.handleBranchDeleteConfirm("n"); cmd != nil || cancelled.BranchDeleteConfirm {
+				t.Error("expected any other key to cancel the delete")
+			}
+			if _, cmd := m.handleBranchDeleteConfirm("y"); cmd == nil {
+				t.Error("expected y to delete the branch")
+			}
+		})
+	}
+}
+
+func TestBranchInput(t *testing.T) {
+	m := newBranchModel(repomanager.Branch{Name: "feature"}, repomanager.Branch{Name: "origin/feature", Remote: true})
+
+	m = m.startBranchInput(branchInputRename)
+	if m.BranchInputAction != branchInputRename || m.BranchInput != "feature" {
+		t.Fatalf("expected renaming to start from the current name, got %q", m.BranchInput)
+	}
+	for _, key := range []tea.KeyMsg{{Type: tea.KeyBackspace}, {Type: tea.KeySpace}, {Type: tea.KeyRunes, Runes: []rune("-x")}} {
+		m = m.editBranchInput(key)
+	}
+	if m.BranchInput != "featur-x" {
+		t.Errorf("input = %q", m.BranchInput)
+	}
+	if got, cmd := m.submitBranchInput(); cmd == nil || got.BranchInputAction != "" {
+		t.Error("expected submitting to rename the branch and stop typing")
+	}
+
+	m = m.cancelBranchInput().moveBranchCursor(1).startBranchInput(branchInputRename)
+	if m.BranchInputAction != "" || m.BranchStatus == "" {
+		t.Error("expected renaming a remote-tracking branch to be refused")
+	}
+	if m = m.startBranchInput(branchInputCreate); m.BranchInputAction != branchInputCreate || m.BranchInput != "" {
+		t.Error("expected creating a branch from a remote-tracking branch to start with an empty name")
+	}
+}
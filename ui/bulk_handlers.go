@@ -0,0 +1,280 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// Filters that mark every list item they match in select mode.
+const (
+	markAll      = "all"
+	markDirty    = "dirty"
+	markUnpushed = "unpushed"
+	markBehind   = "behind"
+)
+
+// BulkEntry is the progress of a bulk operation on one marked repository or worktree.
+type BulkEntry struct {
+	Name string
+	Path string
+	Done bool
+	Err  error
+}
+
+// BulkItemDone carries the outcome of the bulk operation on one repository or worktree.
+type BulkItemDone struct {
+	Result repomanager.BulkResult
+}
+
+// BulkComplete indicates that the running bulk operation finished for every item.
+type BulkComplete struct{}
+
+// bulkOperation runs a bulk operation on a single repository or worktree.
+type bulkOperation func(ctx context.Context, path string) error
+
+// toggleSelectMode enters select mode, or leaves it and clears the marks.
+func (m Model) toggleSelectMode() Model {
+	m.SelectMode = !m.SelectMode
+	m.Marked = make(map[string]bool)
+	return m
+}
+
+// toggleMark marks or unmarks a single item.
+func (m Model) toggleMark(item types.NavigableItem) Model {
+	marked := m.copyMarks()
+	if marked[item.Path()] {
+		delete(marked, item.Path())
+	} else {
+		marked[item.Path()] = true
+	}
+	m.Marked = marked
+	return m
+}
+
+// markMatching adds every item matching the filter to the marks.
+func (m Model) markMatching(filter string) Model {
+	marked := m.copyMarks()
+	for _, item := range m.getNavigableItems() {
+		if matchesMarkFilter(item, filter) {
+			marked[item.Path()] = true
+		}
+	}
+	m.Marked = marked
+	return m
+}
+
+// clearMarks unmarks every item while staying in select mode.
+func (m Model) clearMarks() Model {
+	m.Marked = make(map[string]bool)
+	return m
+}
+
+// copyMarks returns a copy of the marks, so earlier models are left untouched.
+func (m Model) copyMarks() map[string]bool {
+	marked := make(map[string]bool, len(m.Marked))
+	for path := range m.Marked {
+		marked[path] = true
+	}
+	return marked
+}
+
+// markedItems returns the marked items in list order.
+func (m Model) markedItems() []types.NavigableItem {
+	var items []types.NavigableItem
+	for _, item := range m.getNavigableItems() {
+		if m.Marked[item.Path()] {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// matchesMarkFilter reports whether the status of an item matches a mark filter.
+// Bare repositories have no working tree or branch, so only "all" matches them.
+func matchesMarkFilter(item types.NavigableItem, filter string) bool {
+	if filter == markAll {
+		return true
+	}
+
+	var dirty, unpushed, behind bool
+	switch {
+	case item.Type == "worktree" && item.WorktreeInfo != nil:
+		dirty = item.WorktreeInfo.HasUncommitted || item.WorktreeInfo.HasUntracked
+		unpushed = item.WorktreeInfo.HasUnpushed
+		behind = item.WorktreeInfo.HasBehind
+	case item.Repository != nil && !item.Repository.IsBare:
+		dirty = item.Repository.HasUncommitted || item.Repository.HasUntracked
+		unpushed = item.Repository.HasUnpushed
+		behind = item.Repository.HasBehind
+	}
+
+	switch filter {
+	case markDirty:
+		return dirty
+	case markUnpushed:
+		return unpushed
+	case markBehind:
+		return behind
+	default:
+		return false
+	}
+}
+
+// startBulkGit fetches, pushes or pulls every marked item. Pushing and pulling
+// need a working tree, so marked bare repositories are left out of those.
+func (m Model) startBulkGit(action string) (Model, tea.Cmd) {
+	repoManager := m.Dependencies.GetRepoManager()
+
+	var op bulkOperation
+	switch action {
+	case "fetch":
+		op = repoManager.Fetch
+	case "push":
+		op = func(ctx context.Context, path string) error {
+			return repoManager.Push(ctx, path, func(repomanager.OutputLine) {})
+		}
+	case "pull":
+		op = func(ctx context.Context, path string) error {
+			return repoManager.Pull(ctx, path, func(repomanager.OutputLine) {})
+		}
+	default:
+		return m, nil
+	}
+
+	var items []types.NavigableItem
+	for _, item := range m.markedItems() {
+		if action == "fetch" || hasWorkingTree(item) {
+			items = append(items, item)
+		}
+	}
+	return m.startBulk(action, bulkEntries(items), op)
+}
+
+// startBulkAction runs a configured action on every marked item without a
+// terminal, so only actions that do not need one are useful in bulk.
+func (m Model) startBulkAction(action config.Action) (Model, tea.Cmd) {
+	op := func(ctx context.Context, path string) error {
+		output, err := action.ExecuteActionContext(ctx, path).CombinedOutput()
+		if err != nil && len(strings.TrimSpace(string(output))) > 0 {
+			return errors.New(strings.TrimSpace(string(output)))
+		}
+		return err
+	}
+	return m.startBulk(strings.ToLower(action.Name), bulkEntries(m.markedItems()), op)
+}
+
+// bulkEntries returns a pending entry for each item.
+func bulkEntries(items []types.NavigableItem) []BulkEntry {
+	entries := make([]BulkEntry, len(items))
+	for i, item := range items {
+		entries[i] = BulkEntry{Name: item.Name(), Path: item.Path()}
+	}
+	return entries
+}
+
+// startBulk runs the operation on the path of every entry in the background, at
+// most as many at once as statuses are refreshed, and shows the results as they
+// come in. Only one bulk operation runs at a time; while one is running its
+// results are shown instead.
+func (m Model) startBulk(action string, entries []BulkEntry, op bulkOperation) (Model, tea.Cmd) {
+	if m.BulkRunning {
+		m.State = BulkView
+		return m, nil
+	}
+	if len(entries) == 0 {
+		return m, nil
+	}
+
+	paths := make([]string, len(entries))
+	for i, entry := range entries {
+		paths[i] = entry.Path
+	}
+
+	repoManager := m.Dependencies.GetRepoManager()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan tea.Msg, len(paths)+1)
+
+	go func() {
+		defer close(events)
+		repoManager.RunBulk(ctx, paths, op, func(result repomanager.BulkResult) {
+			events <- BulkItemDone{Result: result}
+		})
+		events <- BulkComplete{}
+	}()
+
+	m.State = BulkView
+	m.BulkAction = action
+	m.BulkEntries = entries
+	m.BulkOffset = 0
+	m.BulkRunning = true
+	m.BulkEvents = events
+	m.BulkCancel = cancel
+	return m, m.waitForBulkEvent()
+}
+
+// waitForBulkEvent waits for the next result or the completion of the bulk operation.
+func (m Model) waitForBulkEvent() tea.Cmd {
+	events := m.BulkEvents
+	if events == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		return <-events
+	}
+}
+
+// handleBulkItemDone records the outcome for one item and waits for the next event.
+func (m Model) handleBulkItemDone(msg BulkItemDone) (tea.Model, tea.Cmd) {
+	entries := make([]BulkEntry, len(m.BulkEntries))
+	copy(entries, m.BulkEntries)
+	for i := range entries {
+		if entries[i].Path == msg.Result.Path {
+			entries[i].Done = true
+			entries[i].Err = msg.Result.Err
+		}
+	}
+
+	if msg.Result.Err != nil && !errors.Is(msg.Result.Err, context.Canceled) {
+		logging.Get().Error("bulk operation failed", "action", m.BulkAction, "path", msg.Result.Path, "error", msg.Result.Err)
+	}
+
+	m.BulkEntries = entries
+	return m, m.waitForBulkEvent()
+}
+
+// handleBulkComplete finishes the bulk operation and refreshes statuses, since
+// fetching, pushing and pulling change ahead and behind counts.
+func (m Model) handleBulkComplete(BulkComplete) (tea.Model, tea.Cmd) {
+	if m.BulkCancel != nil {
+		m.BulkCancel()
+	}
+	m.BulkRunning = false
+	m.BulkEvents = nil
+	m.BulkCancel = nil
+
+	m, refresh := m.requestRefresh()
+	return m, refresh
+}
+
+// cancelBulk stops starting new operations; the ones already running are
+// cancelled as well and every item still reports its outcome.
+func (m Model) cancelBulk() Model {
+	if m.BulkRunning && m.BulkCancel != nil {
+		m.BulkCancel()
+	}
+	return m
+}
+
+// scrollBulk moves the visible window of results by delta entries.
+func (m Model) scrollBulk(delta int) Model {
+	m.BulkOffset = min(max(m.BulkOffset+delta, 0), max(len(m.BulkEntries)-1, 0))
+	return m
+}
@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// newSelectModel returns a model in select mode listing a clean, a dirty and a
+// bare repository with an unpushed worktree.
+func newSelectModel() Model {
+	clean := &repomanager.RepoItem{Name: "clean", Path: "/clean"}
+	dirty := &repomanager.RepoItem{Name: "dirty", Path: "/dirty", HasUntracked: true, HasBehind: true}
+	bare := &repomanager.RepoItem{Name: "bare", Path: "/bare", IsBare: true}
+	feature := &repomanager.SubItem{Name: "feature", Path: "/bare/feature", HasUnpushed: true}
+	bare.SubItems = []*repomanager.SubItem{feature}
+
+	m := Model{Dependencies: stubDependencies{}}.toggleSelectMode()
+	m.CachedNavItems = []types.NavigableItem{
+		{Type: "repository", Repository: clean},
+		{Type: "repository", Repository: dirty},
+		{Type: "repository", Repository: bare},
+		{Type: "worktree", WorktreeInfo: feature, ParentRepo: bare},
+	}
+	return m
+}
+
+func TestMarkMatching(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{markAll, []string{"/clean", "/dirty", "/bare", "/bare/feature"}},
+		{markDirty, []string{"/dirty"}},
+		{markUnpushed, []string{"/bare/feature"}},
+		{markBehind, []string{"/dirty"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			m := newSelectModel().markMatching(tt.filter)
+			marked := m.markedItems()
+			if len(marked) != len(tt.want) {
+				t.Fatalf("marked %d items, want %v", len(marked), tt.want)
+			}
+			for i, item := range marked {
+				if item.Path() != tt.want[i] {
+					t.Errorf("marked item %d = %s, want %s", i, item.Path(), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestToggleMark(t *testing.T) {
+	m := newSelectModel()
+	item := m.CachedNavItems[0]
+
+	marked := m.toggleMark(item)
+	if !marked.Marked["/clean"] || m.Marked["/clean"] {
+		t.Fatal("expected the mark to be set on a copy")
+	}
+	if marked.toggleMark(item).Marked["/clean"] {
+		t.Error("expected a second toggle to unmark the item")
+	}
+	if left := marked.toggleSelectMode(); left.SelectMode || len(left.Marked) != 0 {
+		t.Error("expected leaving select mode to clear the marks")
+	}
+}
+
+func TestHandleBulkItemDone(t *testing.T) {
+	m := newRefreshModel(0)
+	m.State = BulkView
+	m.BulkRunning = true
+	m.BulkEvents = make(chan tea.Msg)
+	m.BulkEntries = []BulkEntry{{Name: "a", Path: "/a"}, {Name: "b", Path: "/b"}}
+
+	updated, cmd := m.handleBulkItemDone(BulkItemDone{Result: repomanager.BulkResult{Path: "/b", Err: errors.New("rejected")}})
+	got := updated.(Model)
+	if cmd == nil {
+		t.Fatal("expected to wait for the next result")
+	}
+	if got.BulkEntries[0].Done || !got.BulkEntries[1].Done || got.BulkEntries[1].Err == nil {
+		t.Errorf("unexpected entries %+v", got.BulkEntries)
+	}
+	if m.BulkEntries[1].Done {
+		t.Error("expected the previous model to be left untouched")
+	}
+
+	updated, cmd = got.handleBulkComplete(BulkComplete{})
+	if done := updated.(Model); done.BulkRunning || done.BulkEvents != nil || cmd == nil {
+		t.Error("expected the bulk operation to be finished and statuses refreshed")
+	}
+}
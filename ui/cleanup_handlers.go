@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/cleanup"
+)
+
+// CleanupReportLoaded carries the branch cleanup report of all tracked repositories.
+type CleanupReportLoaded struct {
+	Report repomanager.CleanupReport
+}
+
+// openCleanup switches to the branch cleanup report and reads it.
+func (m Model) openCleanup() (Model, tea.Cmd) {
+	m.State = CleanupView
+	m.CleanupBranches = nil
+	m.CleanupChecked = nil
+	m.CleanupCursor = 0
+	m.CleanupOffset = 0
+	m.CleanupStatus = ""
+	m.CleanupConfirm = false
+	return m.reloadCleanup()
+}
+
+// reloadCleanup reads the cleanup report in the background.
+func (m Model) reloadCleanup() (Model, tea.Cmd) {
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.CleanupLoading = true
+	return m, func() tea.Msg {
+		return CleanupReportLoaded{Report: repoManager.ReadCleanupReport()}
+	}
+}
+
+// handleCleanupReportLoaded stores the report. Merged branches start checked, since
+// deleting them loses no commits; the others need to be checked by hand.
+func (m Model) handleCleanupReportLoaded(msg CleanupReportLoaded) (tea.Model, tea.Cmd) {
+	if m.State != CleanupView {
+		return m, nil
+	}
+
+	m.CleanupLoading = false
+	m.CleanupBranches = msg.Report.Branches
+	m.CleanupStaleDays = msg.Report.StaleDays
+	m.CleanupChecked = make([]bool, len(msg.Report.Branches))
+	for i, branch := range msg.Report.Branches {
+		m.CleanupChecked[i] = branch.Merged
+	}
+
+	m.CleanupStatus = ""
+	if len(msg.Report.Failed) > 0 {
+		var paths []string
+		for _, failed := range msg.Report.Failed {
+			paths = append(paths, failed.Path)
+		}
+		m.CleanupStatus = "Could not read branches of " + strings.Join(paths, ", ")
+	}
+	return m.moveCleanupCursor(0), nil
+}
+
+// moveCleanupCursor moves the selection in the cleanup report by delta branches.
+func (m Model) moveCleanupCursor(delta int) Model {
+	m.CleanupCursor, m.CleanupOffset = scrollWindow(m.CleanupCursor, m.CleanupOffset, delta, len(m.CleanupBranches), cleanup.VisibleRows(m.Height))
+	return m
+}
+
+// toggleCleanupCheck checks or unchecks the selected branch.
+func (m Model) toggleCleanupCheck() Model {
+	if m.CleanupCursor >= len(m.CleanupChecked) {
+		return m
+	}
+
+	checked := make([]bool, len(m.CleanupChecked))
+	copy(checked, m.CleanupChecked)
+	checked[m.CleanupCursor] = !checked[m.CleanupCursor]
+	m.CleanupChecked = checked
+	return m
+}
+
+// checkAllCleanup checks or unchecks every branch.
+func (m Model) checkAllCleanup(check bool) Model {
+	checked := make([]bool, len(m.CleanupBranches))
+	for i := range checked {
+		checked[i] = check
+	}
+	m.CleanupChecked = checked
+	return m
+}
+
+// checkedCleanupBranches returns the branches checked for deletion.
+func (m Model) checkedCleanupBranches() []repomanager.CleanupBranch {
+	var checked []repomanager.CleanupBranch
+	for i, branch := range m.CleanupBranches {
+		if i < len(m.CleanupChecked) && m.CleanupChecked[i] {
+			checked = append(checked, branch)
+		}
+	}
+	return checked
+}
+
+// confirmCleanup shows the confirmation screen for the checked branches.
+func (m Model) confirmCleanup() Model {
+	if len(m.checkedCleanupBranches()) == 0 {
+		m.CleanupStatus = "No branches checked"
+		return m
+	}
+	m.CleanupConfirm = true
+	return m
+}
+
+// handleCleanupConfirm deletes the checked branches when the key is y, one bulk
+// entry per repository, and otherwise returns to the report.
+func (m Model) handleCleanupConfirm(keyStr string) (Model, tea.Cmd) {
+	m.CleanupConfirm = false
+	if keyStr != "y" {
+		return m, nil
+	}
+	if m.BulkRunning {
+		m.CleanupStatus = "Wait for the running bulk operation to finish"
+		return m, nil
+	}
+
+	var entries []BulkEntry
+	names := make(map[string][]string)
+	for _, branch := range m.checkedCleanupBranches() {
+		if _, seen := names[branch.RepoPath]; !seen {
+			entries = append(entries, BulkEntry{Name: branch.RepoName, Path: branch.RepoPath})
+		}
+		names[branch.RepoPath] = append(names[branch.RepoPath], branch.Branch.Name)
+	}
+	for i := range entries {
+		entries[i].Name = fmt.Sprintf("%s: %s", entries[i].Name, strings.Join(names[entries[i].Path], ", "))
+	}
+
+	repoManager := m.Dependencies.GetRepoManager()
+	return m.startBulk("branch cleanup", entries, func(ctx context.Context, path string) error {
+		return repoManager.DeleteBranches(ctx, path, names[path])
+	})
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+// newCleanupModel returns a model showing a loaded cleanup report.
+func newCleanupModel() Model {
+	m := Model{Dependencies: stubDependencies{}, State: CleanupView, CleanupLoading: true}
+	report := repomanager.CleanupReport{
+		Branches: []repomanager.CleanupBranch{
+			{RepoPath: "/a", RepoName: "a", Branch: repomanager.Branch{Name: "done"}, Merged: true},
+			{RepoPath: "/a", RepoName: "a", Branch: repomanager.Branch{Name: "old"}, Stale: true},
+			{RepoPath: "/b", RepoName: "b", Branch: repomanager.Branch{Name: "gone"}, Gone: true, Merged: true},
+		},
+		StaleDays: 90,
+		Failed:    []repomanager.BulkResult{{Path: "/c", Err: errors.New("not a git repository")}},
+	}
+	updated, _ := m.handleCleanupReportLoaded(CleanupReportLoaded{Report: report})
+	return updated.(Model)
+}
+
+func TestHandleCleanupReportLoaded(t *testing.T) {
+	m := newCleanupModel()
+
+	if m.CleanupLoading || len(m.CleanupBranches) != 3 || m.CleanupStaleDays != 90 {
+		t.Fatalf("report not stored: %+v", m.CleanupBranches)
+	}
+	if want := []bool{true, false, true}; len(m.CleanupChecked) != 3 ||
+		m.CleanupChecked[0] != want[0] || m.CleanupChecked[1] != want[1] || m.CleanupChecked[2] != want[2] {
+		t.Errorf("checked = %v, want only merged branches", m.CleanupChecked)
+	}
+	if m.CleanupStatus != "Could not read branches of /c" {
+		t.Errorf("status = %q", m.CleanupStatus)
+	}
+}
+
+func TestConfirmCleanup(t *testing.T) {
+	m := newCleanupModel().checkAllCleanup(false)
+	if got := m.confirmCleanup(); got.CleanupConfirm || got.CleanupStatus != "No branches checked" {
+		t.Errorf("expected nothing to confirm, got status %q", got.CleanupStatus)
+	}
+
+	m = m.moveCleanupCursor(1).toggleCleanupCheck().confirmCleanup()
+	if !m.CleanupConfirm {
+		t.Fatal("expected the confirmation screen")
+	}
+	if checked := m.checkedCleanupBranches(); len(checked) != 1 || checked[0].Branch.Name != "old" {
+		t.Errorf("checked = %+v", checked)
+	}
+
+	// Any key other than y goes back without deleting
+	got, cmd := m.handleCleanupConfirm("n")
+	if got.CleanupConfirm || cmd != nil || got.BulkRunning {
+		t.Error("expected to return to the report without deleting")
+	}
+
+	m.BulkRunning = true
+	got, cmd = m.handleCleanupConfirm("y")
+	if cmd != nil || got.CleanupStatus != "Wait for the running bulk operation to finish" {
+		t.Errorf("expected the delete to wait for the running bulk operation, got status %q", got.CleanupStatus)
+	}
+}
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// CommitComplete indicates that git commit finished for the item in the commit composer.
+type CommitComplete struct {
+	Path   string
+	Amend  bool
+	Commit repomanager.CommitInfo // New HEAD commit, zero on failure
+	Err    error
+}
+
+// CommitMessageLoaded carries the message of the HEAD commit, used to prefill an amend.
+type CommitMessageLoaded struct {
+	Path    string
+	Message string
+	Err     error
+}
+
+// openCommit switches to the commit composer of an item and loads its changed files.
+// Bare repositories have nothing to commit, so they are ignored.
+func (m Model) openCommit(item types.NavigableItem) (Model, tea.Cmd) {
+	if !hasWorkingTree(item) {
+		return m, nil
+	}
+
+	// The details view already shows this item's files, the list does not
+	if m.State != DetailsView {
+		m.Files = nil
+	}
+	m.CommitReturnState = m.State
+	m.State = CommitView
+	m.SelectedNavItem = &item
+	m.CommitMessage = ""
+	m.CommitAmend = false
+	m.CommitSignOff = false
+	m.CommitRunning = false
+	m.CommitStatus = ""
+	return m, m.loadChangedFiles(item)
+}
+
+// closeCommit returns to the view the composer was opened from.
+func (m Model) closeCommit() Model {
+	m.State = m.CommitReturnState
+	if m.State == ListView {
+		m.SelectedNavItem = nil
+	}
+	return m
+}
+
+// editCommitMessage applies a key press to the message: text is appended, Enter
+// starts a new line and Backspace removes the last character.
+func (m Model) editCommitMessage(msg tea.KeyMsg) Model {
+	if msg.Alt {
+		return m
+	}
+
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.CommitMessage += "\n"
+	case tea.KeySpace:
+		m.CommitMessage += " "
+	case tea.KeyRunes:
+		m.CommitMessage += string(msg.Runes)
+	case tea.KeyBackspace:
+		if runes := []rune(m.CommitMessage); len(runes) > 0 {
+			m.CommitMessage = string(runes[:len(runes)-1])
+		}
+	}
+	return m
+}
+
+// toggleCommitAmend switches between a new commit and amending HEAD. Turning amend
+// on with an empty message loads the message of the commit being amended.
+func (m Model) toggleCommitAmend() (Model, tea.Cmd) {
+	m.CommitAmend = !m.CommitAmend
+	m.CommitStatus = ""
+	if !m.CommitAmend || m.SelectedNavItem == nil || strings.TrimSpace(m.CommitMessage) != "" {
+		return m, nil
+	}
+
+	path := m.SelectedNavItem.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return m, func() tea.Msg {
+		message, err := repoManager.ReadCommitMessage(path)
+		return CommitMessageLoaded{Path: path, Message: message, Err: err}
+	}
+}
+
+// handleCommitMessageLoaded prefills the message unless something was typed meanwhile.
+func (m Model) handleCommitMessageLoaded(msg CommitMessageLoaded) (tea.Model, tea.Cmd) {
+	if m.State != CommitView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	if msg.Err != nil {
+		m.CommitStatus = "Failed to read the last commit message: " + msg.Err.Error()
+		return m, nil
+	}
+	if m.CommitAmend && strings.TrimSpace(m.CommitMessage) == "" {
+		m.CommitMessage = msg.Message
+	}
+	return m, nil
+}
+
+// runCommit commits the staged changes in the background after checking that there
+// is a message and, unless amending, something staged.
+func (m Model) runCommit() (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.CommitRunning {
+		return m, nil
+	}
+
+	if strings.TrimSpace(m.CommitMessage) == "" {
+		m.CommitStatus = "Enter a commit message"
+		return m, nil
+	}
+	if !m.CommitAmend && !hasStagedFiles(m.Files) {
+		m.CommitStatus = "Nothing staged to commit"
+		return m, nil
+	}
+
+	path := m.SelectedNavItem.Path()
+	opts := repomanager.CommitOptions{
+		Message: m.CommitMessage,
+		Amend:   m.CommitAmend,
+		SignOff: m.CommitSignOff,
+	}
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.CommitRunning = true
+	m.CommitStatus = ""
+	return m, func() tea.Msg {
+		commit, err := repoManager.CreateCommit(path, opts)
+		return CommitComplete{Path: path, Amend: opts.Amend, Commit: commit, Err: err}
+	}
+}
+
+// hasStagedFiles reports whether any of the changed files has staged changes.
+func hasStagedFiles(files []repomanager.ChangedFile) bool {
+	for _, file := range files {
+		if file.Group == repomanager.FileStaged {
+			return true
+		}
+	}
+	return false
+}
+
+// handleCommitComplete keeps the composer open with the git error on failure, and
+// otherwise returns to the previous view and refreshes the item.
+func (m Model) handleCommitComplete(msg CommitComplete) (tea.Model, tea.Cmd) {
+	if m.State != CommitView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		m, refresh := m.requestRefresh()
+		return m, refresh
+	}
+
+	m.CommitRunning = false
+	if msg.Err != nil {
+		logging.Get().Error("failed to commit", "error", msg.Err, "path", msg.Path)
+		m.CommitStatus = "Commit failed: " + msg.Err.Error()
+		return m, nil
+	}
+
+	verb := "Committed"
+	if msg.Amend {
+		verb = "Amended"
+	}
+
+	m = m.closeCommit()
+	var cmds []tea.Cmd
+	if m.State == DetailsView {
+		m.FileStatus = fmt.Sprintf("%s %s %s", verb, msg.Commit.Hash, msg.Commit.Subject)
+		cmds = append(cmds, m.loadChangedFiles(*m.SelectedNavItem))
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, tea.Batch(append(cmds, refresh)...)
+}
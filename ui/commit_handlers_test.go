@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+func TestEditCommitMessage(t *testing.T) {
+	m := Model{}
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("Fix")},
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("bugé")},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyRunes, Runes: []rune("x"), Alt: true},
+		{Type: tea.KeyRunes, Runes: []rune("Body?")},
+	}
+	for _, key := range keys {
+		m = m.editCommitMessage(key)
+	}
+
+	if want := "Fix bug\n\nBody?"; m.CommitMessage != want {
+		t.Errorf("message = %q, want %q", m.CommitMessage, want)
+	}
+}
+
+func TestRunCommit_Validation(t *testing.T) {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/repo"}}
+	m := Model{
+		Dependencies:    stubDependencies{},
+		State:           CommitView,
+		SelectedNavItem: &item,
+		Files:           []repomanager.ChangedFile{{Path: "file.txt", Group: repomanager.FileUnstaged, Code: 'M'}},
+	}
+
+	if got, cmd := m.runCommit(); cmd != nil || got.CommitStatus != "Enter a commit message" {
+		t.Errorf("expected an empty message to be refused, got status %q", got.CommitStatus)
+	}
+
+	m.CommitMessage = "Fix bug"
+	if got, cmd := m.runCommit(); cmd != nil || got.CommitStatus != "Nothing staged to commit" {
+		t.Errorf("expected a commit without staged files to be refused, got status %q", got.CommitStatus)
+	}
+
+	m.CommitAmend = true
+	got, cmd := m.runCommit()
+	if cmd == nil || !got.CommitRunning {
+		t.Fatal("expected amending without staged files to run")
+	}
+	if _, cmd := got.runCommit(); cmd != nil {
+		t.Error("expected no second commit while one is running")
+	}
+}
+
+func TestHandleCommitComplete(t *testing.T) {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/repo"}}
+	m := Model{
+		Dependencies:      stubDependencies{},
+		State:             CommitView,
+		CommitReturnState: ListView,
+		SelectedNavItem:   &item,
+		CommitMessage:     "Fix bug",
+		CommitRunning:     true,
+	}
+
+	updated, _ := m.handleCommitComplete(CommitComplete{Path: "/repo", Err: errors.New("pre-commit hook failed")})
+	failed := updated.(Model)
+	if failed.State != CommitView || failed.CommitRunning || failed.CommitMessage != "Fix bug" {
+		t.Fatal("expected the composer to stay open with the message after a failure")
+	}
+	if failed.CommitStatus != "Commit failed: pre-commit hook failed" {
+		t.Errorf("status = %q", failed.CommitStatus)
+	}
+
+	updated, cmd := m.handleCommitComplete(CommitComplete{Path: "/repo", Commit: repomanager.CommitInfo{Hash: "abc1234", Subject: "Fix bug"}})
+	done := updated.(Model)
+	if done.State != ListView || done.SelectedNavItem != nil || cmd == nil {
+		t.Error("expected a successful commit to return to the list and refresh")
+	}
+}
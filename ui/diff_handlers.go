@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/diff"
+)
+
+// diffColumnStep is the number of columns the diff scrolls sideways per key press.
+const diffColumnStep = 8
+
+// DiffLoaded carries the diff requested for the item shown in the diff view.
+type DiffLoaded struct {
+	Path    string
+	Options repomanager.DiffOptions
+	Diff    repomanager.Diff
+	Err     error
+}
+
+// openDiff switches to the diff of the selected changed file, or of the whole
+// working tree when wholeTree is set or there are no changed files.
+func (m Model) openDiff(wholeTree bool) (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || !hasWorkingTree(*m.SelectedNavItem) {
+		return m, nil
+	}
+
+	var opts repomanager.DiffOptions
+	if !wholeTree && m.FileCursor < len(m.Files) {
+		file := m.Files[m.FileCursor]
+		opts = repomanager.DiffOptions{
+			File:      file.Path,
+			Staged:    file.Group == repomanager.FileStaged,
+			Untracked: file.Group == repomanager.FileUntracked,
+		}
+	}
+
+	m.State = DiffView
+	m.DiffOptions = opts
+	m.Diff = repomanager.Diff{}
+	m.DiffOffset = 0
+	m.DiffColumn = 0
+	m.DiffStatus = ""
+	m.DiffLoading = true
+	return m, m.loadDiff(m.SelectedNavItem.Path(), opts)
+}
+
+// loadDiff reads the diff of the repository at path.
+func (m Model) loadDiff(path string, opts repomanager.DiffOptions) tea.Cmd {
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return func() tea.Msg {
+		result, err := repoManager.ReadDiff(path, opts)
+		return DiffLoaded{Path: path, Options: opts, Diff: result, Err: err}
+	}
+}
+
+// handleDiffLoaded stores the diff if the diff view still shows the same comparison.
+func (m Model) handleDiffLoaded(msg DiffLoaded) (tea.Model, tea.Cmd) {
+	if m.State != DiffView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path || m.DiffOptions != msg.Options {
+		return m, nil
+	}
+
+	m.DiffLoading = false
+	if msg.Err != nil {
+		m.DiffStatus = "Failed to read diff: " + msg.Err.Error()
+	}
+	m.Diff = msg.Diff
+	return m.scrollDiff(0), nil
+}
+
+// toggleDiffStaged switches between the staged and unstaged changes and reads them.
+func (m Model) toggleDiffStaged() (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil {
+		return m, nil
+	}
+
+	m.DiffOptions.Staged = !m.DiffOptions.Staged
+	m.DiffStatus = ""
+	m.DiffOffset = 0
+	m.DiffColumn = 0
+	m.DiffLoading = true
+	return m, m.loadDiff(m.SelectedNavItem.Path(), m.DiffOptions)
+}
+
+// closeDiff returns to the details view the diff was opened from.
+func (m Model) closeDiff() Model {
+	m.State = DetailsView
+	m.Diff = repomanager.Diff{}
+	return m
+}
+
+// scrollDiff moves the diff by delta lines, keeping the last line reachable.
+func (m Model) scrollDiff(delta int) Model {
+	m.DiffOffset = min(max(m.DiffOffset+delta, 0), max(len(m.Diff.Lines)-1, 0))
+	return m
+}
+
+// jumpToHunk scrolls to the next hunk when step is positive, or the previous one otherwise.
+func (m Model) jumpToHunk(step int) Model {
+	current := diff.CurrentHunk(m.Diff, m.DiffOffset)
+	if step < 0 && current >= 0 && m.Diff.Hunks[current] < m.DiffOffset {
+		// Scrolled into a hunk: going back starts at its own header first
+		m.DiffOffset = m.Diff.Hunks[current]
+		return m
+	}
+
+	target := current + step
+	if target >= 0 && target < len(m.Diff.Hunks) {
+		m.DiffOffset = m.Diff.Hunks[target]
+	}
+	return m
+}
+
+// scrollDiffColumn scrolls unwrapped diff lines sideways.
+func (m Model) scrollDiffColumn(delta int) Model {
+	if m.DiffWrap {
+		return m
+	}
+	m.DiffColumn = max(m.DiffColumn+delta, 0)
+	return m
+}
@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+func TestJumpToHunk(t *testing.T) {
+	m := Model{Diff: repomanager.Diff{
+		Lines: make([]repomanager.DiffLine, 30),
+		Hunks: []int{4, 12, 20},
+	}}
+
+	steps := []struct {
+		name string
+		move func(Model) Model
+		want int
+	}{
+		{"next from the file header", func(m Model) Model { return m.jumpToHunk(1) }, 4},
+		{"next", func(m Model) Model { return m.jumpToHunk(1) }, 12},
+		{"scroll into the hunk", func(m Model) Model { return m.scrollDiff(3) }, 15},
+		{"previous starts at the current header", func(m Model) Model { return m.jumpToHunk(-1) }, 12},
+		{"previous", func(m Model) Model { return m.jumpToHunk(-1) }, 4},
+		{"previous stays on the first hunk", func(m Model) Model { return m.jumpToHunk(-1) }, 4},
+		{"last", func(m Model) Model { return m.jumpToHunk(1).jumpToHunk(1) }, 20},
+		{"next stays on the last hunk", func(m Model) Model { return m.jumpToHunk(1) }, 20},
+		{"scroll stops at the last line", func(m Model) Model { return m.scrollDiff(100) }, 29},
+	}
+	for _, step := range steps {
+		m = step.move(m)
+		if m.DiffOffset != step.want {
+			t.Fatalf("%s: offset = %d, want %d", step.name, m.DiffOffset, step.want)
+		}
+	}
+}
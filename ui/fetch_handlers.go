@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+// FetchRoundComplete indicates that the background fetch scheduler finished a round.
+type FetchRoundComplete struct {
+	Results []repomanager.FetchResult
+}
+
+// startFetchScheduler starts background fetching when it is enabled in the configuration.
+func (m Model) startFetchScheduler() tea.Cmd {
+	if m.FetchScheduler == nil {
+		return nil
+	}
+
+	m.FetchScheduler.Start()
+	return m.waitForFetchRound()
+}
+
+// waitForFetchRound waits for the next completed fetch round.
+func (m Model) waitForFetchRound() tea.Cmd {
+	rounds := m.FetchScheduler.Rounds()
+	return func() tea.Msg {
+		return FetchRoundComplete{Results: <-rounds}
+	}
+}
+
+// handleFetchRound logs failed fetches, refreshes statuses so new upstream commits
+// show up and waits for the next round.
+func (m Model) handleFetchRound(msg FetchRoundComplete) (tea.Model, tea.Cmd) {
+	for _, result := range msg.Results {
+		if result.Err != nil {
+			logging.Get().Warn("background fetch failed", "path", result.Path, "error", result.Err)
+		}
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, tea.Batch(
+		refresh,
+		m.waitForFetchRound(),
+	)
+}
@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/details"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// Sections of the details view that can hold the keyboard focus.
+const (
+	detailsFocusFiles   = "files"
+	detailsFocusStashes = "stashes"
+)
+
+// ChangedFilesLoaded carries the changed files of the item shown in the details view.
+type ChangedFilesLoaded struct {
+	Path  string
+	Files []repomanager.ChangedFile
+	Err   error
+}
+
+// EditorClosed indicates that the editor opened on a changed file exited.
+type EditorClosed struct {
+	Path string
+	Err  error
+}
+
+// hasWorkingTree reports whether the item has a working tree to list changes of.
+func hasWorkingTree(item types.NavigableItem) bool {
+	return item.Type == "worktree" || (item.Repository != nil && !item.Repository.IsBare)
+}
+
+// loadChangedFiles reads the changed files of the item, or nothing for bare repositories.
+func (m Model) loadChangedFiles(item types.NavigableItem) tea.Cmd {
+	if !hasWorkingTree(item) {
+		return nil
+	}
+
+	path := item.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return func() tea.Msg {
+		files, err := repoManager.ListChangedFiles(path)
+		return ChangedFilesLoaded{Path: path, Files: files, Err: err}
+	}
+}
+
+// handleChangedFilesLoaded stores the changed files if the details view still shows the same item.
+func (m Model) handleChangedFilesLoaded(msg ChangedFilesLoaded) (tea.Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	if msg.Err != nil {
+		m.FileStatus = "Failed to list changed files: " + msg.Err.Error()
+	}
+	m.Files = msg.Files
+	return m.moveFileCursor(0), nil
+}
+
+// moveFileCursor moves the file selection by delta and scrolls the list so the
+// selected file stays visible.
+func (m Model) moveFileCursor(delta int) Model {
+	m.FileCursor, _ = scrollWindow(m.FileCursor, 0, delta, len(m.Files), details.FileRows)
+
+	// Scroll by rows, which include the group headings
+	rows := details.FileRowCount(m.Files)
+	row := details.FileRow(m.Files, m.FileCursor)
+	if m.FileCursor == 0 {
+		// Keep the heading of the first group visible at the top
+		row = 0
+	}
+	m.FileOffset = min(m.FileOffset, max(rows-details.FileRows, 0))
+	_, m.FileOffset = scrollWindow(row, m.FileOffset, 0, rows, details.FileRows)
+	return m
+}
+
+// toggleDetailsFocus moves the keyboard focus between the changed files and the stashes.
+func (m Model) toggleDetailsFocus() Model {
+	if m.DetailsFocus == detailsFocusStashes {
+		m.DetailsFocus = detailsFocusFiles
+	} else {
+		m.DetailsFocus = detailsFocusStashes
+	}
+	return m
+}
+
+// openFileInEditor opens the selected changed file with the configured editor action.
+func (m Model) openFileInEditor() (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.FileCursor >= len(m.Files) {
+		return m, nil
+	}
+
+	repoPath := m.SelectedNavItem.Path()
+	filePath := filepath.Join(repoPath, m.Files[m.FileCursor].Path)
+	action := m.Config.Keybindings.EditorAction()
+
+	cmd := action.ExecuteOpenAction(filePath)
+	cmd.Dir = repoPath
+	return m, tea.ExecProcess(cmd, func(err error) tea.Msg {
+		if err != nil {
+			logging.Get().Error("failed to run editor",
+				"error", err,
+				"path", filePath,
+				"command", action.Command)
+		}
+		return EditorClosed{Path: repoPath, Err: err}
+	})
+}
+
+// handleEditorClosed reloads the changed files and statuses, since the file may have been edited.
+func (m Model) handleEditorClosed(msg EditorClosed) (tea.Model, tea.Cmd) {
+	if msg.Err != nil {
+		m.FileStatus = "Editor failed: " + msg.Err.Error()
+		return m, nil
+	}
+
+	m, refresh := m.requestRefresh()
+	if m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, refresh
+	}
+	return m, tea.Batch(m.loadChangedFiles(*m.SelectedNavItem), refresh)
+}
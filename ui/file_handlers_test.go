@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/details"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+func TestMoveFileCursor_ScrollsPastGroupHeadings(t *testing.T) {
+	files := []repomanager.ChangedFile{
+		{Path: "staged.txt", Group: repomanager.FileStaged, Code: 'M'},
+	}
+	for i := range details.FileRows {
+		files = append(files, repomanager.ChangedFile{Path: fmt.Sprintf("new%d.txt", i), Group: repomanager.FileUntracked, Code: '?'})
+	}
+	m := Model{Files: files}
+
+	// Two headings push the last file two rows below the file count
+	m = m.moveFileCursor(len(files))
+	if m.FileCursor != len(files)-1 {
+		t.Fatalf("cursor = %d, want %d", m.FileCursor, len(files)-1)
+	}
+	if want := details.FileRowCount(files) - details.FileRows; m.FileOffset != want {
+		t.Errorf("offset at the bottom = %d, want %d", m.FileOffset, want)
+	}
+
+	m = m.moveFileCursor(-len(files))
+	if m.FileCursor != 0 || m.FileOffset != 0 {
+		t.Errorf("cursor %d and offset %d at the top, want 0 and 0", m.FileCursor, m.FileOffset)
+	}
+
+	// A shorter list after a reload pulls the offset back
+	m.FileOffset = 5
+	m.Files = files[:2]
+	m = m.moveFileCursor(0)
+	if m.FileOffset != 0 {
+		t.Errorf("offset after reload = %d, want 0", m.FileOffset)
+	}
+}
+
+func TestDetailsViewKeys_EditorKeyKeepsBuiltInKeys(t *testing.T) {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/code/app"}}
+	m := Model{
+		Config:          &config.Config{Keybindings: config.Keybindings{Editor: config.Action{Key: "b"}}},
+		State:           DetailsView,
+		SelectedNavItem: &item,
+	}
+
+	model, _ := NewKeyHandler().handleDetailsViewKeys(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if got := model.(Model); got.State != ListView || got.SelectedNavItem != nil {
+		t.Errorf("expected b to return to the list despite the editor key, got state %v", got.State)
+	}
+}
@@ -0,0 +1,33 @@
+package format
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		expected string
+	}{
+		{"zero time", time.Time{}, "never"},
+		{"seconds ago", now.Add(-30 * time.Second), "just now"},
+		{"in the future", now.Add(time.Minute), "just now"},
+		{"minutes ago", now.Add(-5 * time.Minute), "5m ago"},
+		{"hours ago", now.Add(-3 * time.Hour), "3h ago"},
+		{"days ago", now.Add(-2 * 24 * time.Hour), "2d ago"},
+		{"months ago", now.Add(-65 * 24 * time.Hour), "2mo ago"},
+		{"years ago", now.Add(-800 * 24 * time.Hour), "2y ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := RelativeTime(tt.t, now); result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@ func CreateInitialModel(deps Dependencies) Model {
 func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.updateRepositoryStatuses(),
+		m.scheduleRefreshTick(),
+		m.startFetchScheduler(),
+		m.startWatcher(),
 		tea.WindowSize(), // Explicitly request window size
 	)
 }
@@ -25,8 +28,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
+	case StatusItemUpdated:
+		return m.handleStatusItemUpdate(msg)
 	case StatusUpdateComplete:
 		return m.handleStatusUpdate(msg)
+	case RefreshTick:
+		return m.handleRefreshTick(msg)
+	case StashesLoaded:
+		return m.handleStashesLoaded(msg)
+	case StashActionComplete:
+		return m.handleStashAction(msg)
+	case ChangedFilesLoaded:
+		return m.handleChangedFilesLoaded(msg)
+	case EditorClosed:
+		return m.handleEditorClosed(msg)
+	case IndexActionComplete:
+		return m.handleIndexAction(msg)
+	case CommitMessageLoaded:
+		return m.handleCommitMessageLoaded(msg)
+	case CommitComplete:
+		return m.handleCommitComplete(msg)
+	case RemoteOutput:
+		return m.handleRemoteOutput(msg)
+	case RemoteComplete:
+		return m.handleRemoteComplete(msg)
+	case BranchesLoaded:
+		return m.handleBranchesLoaded(msg)
+	case BranchActionComplete:
+		return m.handleBranchActionComplete(msg)
+	case WorktreeBranchesLoaded:
+		return m.handleWorktreeBranchesLoaded(msg)
+	case WorktreeAdded:
+		return m.handleWorktreeAdded(msg)
+	case WorktreeActionComplete:
+		return m.handleWorktreeActionComplete(msg)
+	case CleanupReportLoaded:
+		return m.handleCleanupReportLoaded(msg)
+	case ScanComplete:
+		return m.handleScanComplete(msg)
+	case ScanAdded:
+		return m.handleScanAdded(msg)
+	case BulkItemDone:
+		return m.handleBulkItemDone(msg)
+	case BulkComplete:
+		return m.handleBulkComplete(msg)
+	case DiffLoaded:
+		return m.handleDiffLoaded(msg)
+	case LogLoaded:
+		return m.handleLogLoaded(msg)
+	case CommitDetailLoaded:
+		return m.handleCommitDetailLoaded(msg)
+	case FetchRoundComplete:
+		return m.handleFetchRound(msg)
+	case RepositoriesChanged:
+		return m.handleRepositoriesChanged(msg)
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
 		m.Height = msg.Height
@@ -35,6 +35,12 @@ func (h *Renderer) Render(title string, width int) string {
 
 // RenderWithCount renders a header with title on left and count on right.
 func (h *Renderer) RenderWithCount(appName, configTitle string, count int, width int) string {
+	return h.RenderWithStatus(appName, configTitle, "", count, width)
+}
+
+// RenderWithStatus renders a header with title on left and an optional status
+// text followed by the count on right.
+func (h *Renderer) RenderWithStatus(appName, configTitle, status string, count int, width int) string {
 	if width <= 0 {
 		width = 80 // Default width
 	}
@@ -45,8 +51,11 @@ func (h *Renderer) RenderWithCount(appName, configTitle string, count int, width
 		leftContent = fmt.Sprintf("%s - %s", appName, configTitle)
 	}
 
-	// Build right side: repo count
+	// Build right side: optional status and repo count
 	rightContent := fmt.Sprintf("(%d)", count)
+	if status != "" {
+		rightContent = status + "  " + rightContent
+	}
 
 	// Calculate available space for spacing
 	totalContentWidth := len(leftContent) + len(rightContent)
@@ -75,3 +84,8 @@ func (h *Renderer) RenderWithSpacing(title string, width int) string {
 func (h *Renderer) RenderWithCountAndSpacing(appName, configTitle string, count int, width int) string {
 	return h.RenderWithCount(appName, configTitle, count, width) + "\n"
 }
+
+// RenderWithStatusAndSpacing renders a header with status and count and adds 1 newline below.
+func (h *Renderer) RenderWithStatusAndSpacing(appName, configTitle, status string, count int, width int) string {
+	return h.RenderWithStatus(appName, configTitle, status, count, width) + "\n"
+}
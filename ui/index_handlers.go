@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/diff"
+)
+
+// IndexActionComplete indicates that staging, unstaging or discarding a file or hunk finished.
+type IndexActionComplete struct {
+	Path   string
+	Action string // "stage", "unstage" or "discard"
+	Target string // Description of the file or hunk
+	Err    error
+}
+
+// runFileAction stages, unstages or discards the selected changed file in the background.
+func (m Model) runFileAction(action string) (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.FileCursor >= len(m.Files) {
+		return m, nil
+	}
+
+	file := m.Files[m.FileCursor]
+	if action == "unstage" && file.Group != repomanager.FileStaged {
+		m.FileStatus = file.Path + " has no staged changes"
+		return m, nil
+	}
+	if action == "stage" && file.Group == repomanager.FileStaged {
+		m.FileStatus = file.Path + " is already staged"
+		return m, nil
+	}
+
+	path := m.SelectedNavItem.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.FileStatus = fmt.Sprintf("Running %s on %s...", action, file.Path)
+	return m, func() tea.Msg {
+		var err error
+		switch action {
+		case "stage":
+			err = repoManager.StageFile(path, file)
+		case "unstage":
+			err = repoManager.UnstageFile(path, file)
+		case "discard":
+			err = repoManager.DiscardFile(path, file)
+		}
+		return IndexActionComplete{Path: path, Action: action, Target: file.Path, Err: err}
+	}
+}
+
+// runHunkAction stages, unstages or discards the hunk at the top of the diff view.
+func (m Model) runHunkAction(action string) (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || len(m.Diff.Hunks) == 0 {
+		return m, nil
+	}
+
+	staged := m.DiffOptions.Staged
+	if action == "unstage" && !staged {
+		m.DiffStatus = "Switch to the staged diff (Tab) to unstage a hunk"
+		return m, nil
+	}
+	if action != "unstage" && staged {
+		m.DiffStatus = fmt.Sprintf("Switch to the unstaged diff (Tab) to %s a hunk", action)
+		return m, nil
+	}
+
+	hunk := max(diff.CurrentHunk(m.Diff, m.DiffOffset), 0)
+	target := fmt.Sprintf("hunk %d/%d", hunk+1, len(m.Diff.Hunks))
+	path := m.SelectedNavItem.Path()
+	changes := m.Diff
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.DiffStatus = fmt.Sprintf("Running %s on %s...", action, target)
+	return m, func() tea.Msg {
+		var err error
+		switch action {
+		case "stage":
+			err = repoManager.StageHunk(path, changes, hunk)
+		case "unstage":
+			err = repoManager.UnstageHunk(path, changes, hunk)
+		case "discard":
+			err = repoManager.DiscardHunk(path, changes, hunk)
+		}
+		return IndexActionComplete{Path: path, Action: action, Target: target, Err: err}
+	}
+}
+
+// confirmDiscard asks for confirmation before discarding the selected file or hunk.
+func (m Model) confirmDiscard() Model {
+	switch {
+	case m.State == DiffView && len(m.Diff.Hunks) > 0:
+		hunk := max(diff.CurrentHunk(m.Diff, m.DiffOffset), 0)
+		m.DiscardConfirm = true
+		m.DiffStatus = fmt.Sprintf("Discard hunk %d/%d? Press y to confirm, any other key to cancel", hunk+1, len(m.Diff.Hunks))
+	case m.State == DetailsView && m.FileCursor < len(m.Files):
+		m.DiscardConfirm = true
+		m.FileStatus = fmt.Sprintf("Discard changes to %s? Press y to confirm, any other key to cancel", m.Files[m.FileCursor].Path)
+	}
+	return m
+}
+
+// handleDiscardConfirm runs the pending discard on y and cancels it on any other key.
+func (m Model) handleDiscardConfirm(key string) (Model, tea.Cmd) {
+	m.DiscardConfirm = false
+	if m.State == DiffView {
+		if key == "y" {
+			return m.runHunkAction("discard")
+		}
+		m.DiffStatus = ""
+		return m, nil
+	}
+
+	if key == "y" {
+		return m.runFileAction("discard")
+	}
+	m.FileStatus = ""
+	return m, nil
+}
+
+// handleIndexAction reports the outcome of an index operation and reloads the
+// changed files, the open diff and all statuses.
+func (m Model) handleIndexAction(msg IndexActionComplete) (tea.Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		m, refresh := m.requestRefresh()
+		return m, refresh
+	}
+
+	action := strings.ToUpper(msg.Action[:1]) + msg.Action[1:]
+	status := fmt.Sprintf("%s of %s succeeded", action, msg.Target)
+	if msg.Err != nil {
+		status = fmt.Sprintf("%s of %s failed: %v", action, msg.Target, msg.Err)
+	}
+
+	cmds := []tea.Cmd{m.loadChangedFiles(*m.SelectedNavItem)}
+	if m.State == DiffView {
+		m.DiffStatus = status
+		cmds = append(cmds, m.loadDiff(msg.Path, m.DiffOptions))
+	} else {
+		m.FileStatus = status
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, tea.Batch(append(cmds, refresh)...)
+}
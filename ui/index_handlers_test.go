@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+func TestDiscardNeedsConfirmation(t *testing.T) {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/repo"}}
+	m := Model{
+		Dependencies:    stubDependencies{},
+		State:           DetailsView,
+		SelectedNavItem: &item,
+		Files:           []repomanager.ChangedFile{{Path: "file.txt", Group: repomanager.FileUnstaged, Code: 'M'}},
+	}
+
+	m = m.confirmDiscard()
+	if !m.DiscardConfirm {
+		t.Fatal("expected discard to wait for confirmation")
+	}
+
+	cancelled, cmd := m.handleDiscardConfirm("n")
+	if cmd != nil || cancelled.DiscardConfirm || cancelled.FileStatus != "" {
+		t.Errorf("expected any other key to cancel, got status %q", cancelled.FileStatus)
+	}
+
+	confirmed, cmd := m.handleDiscardConfirm("y")
+	if cmd == nil || confirmed.DiscardConfirm {
+		t.Error("expected y to start the discard")
+	}
+}
+
+func TestRunHunkAction_RequiresMatchingSide(t *testing.T) {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/repo"}}
+	m := Model{
+		Dependencies:    stubDependencies{},
+		State:           DiffView,
+		SelectedNavItem: &item,
+		Diff:            repomanager.Diff{Lines: make([]repomanager.DiffLine, 3), Hunks: []int{1}},
+	}
+
+	if _, cmd := m.runHunkAction("unstage"); cmd != nil {
+		t.Error("expected unstaging from the unstaged diff to be refused")
+	}
+	if _, cmd := m.runHunkAction("stage"); cmd == nil {
+		t.Error("expected staging from the unstaged diff to run")
+	}
+
+	m.DiffOptions.Staged = true
+	if _, cmd := m.runHunkAction("discard"); cmd != nil {
+		t.Error("expected discarding from the staged diff to be refused")
+	}
+}
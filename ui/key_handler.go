@@ -12,6 +12,8 @@ import (
 	"github.com/jarmocluyse/git-dash/internal/logging"
 	"github.com/jarmocluyse/git-dash/internal/theme"
 	"github.com/jarmocluyse/git-dash/ui/components/direxplorer"
+	"github.com/jarmocluyse/git-dash/ui/pages/commitlog"
+	"github.com/jarmocluyse/git-dash/ui/pages/diff"
 )
 
 // KeyHandler manages keyboard input handling for different view states.
@@ -31,15 +33,15 @@ func NewKeyHandler() *KeyHandler {
 // HandleKeyPress dispatches key events to appropriate handlers based on current state.
 func (h *KeyHandler) HandleKeyPress(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Log current state and key press
-	stateNames := []string{"ListView", "SettingsView", "DetailsView", "ActionConfigView"}
+	stateNames := []string{"ListView", "SettingsView", "DetailsView", "ActionConfigView", "LogView", "DiffView", "CommitView", "RemoteView", "BulkView", "BranchView", "CleanupView", "WorktreeView", "WorktreeListView", "ScanView"}
 	stateName := "Unknown"
 	if int(m.State) < len(stateNames) {
 		stateName = stateNames[m.State]
 	}
 	logging.Get().Debug("key pressed", "key", msg.String(), "state", stateName)
 
-	// Global help modal toggle
-	if msg.String() == "?" {
+	// Global help modal toggle, except while typing a commit message
+	if msg.String() == "?" && m.State != CommitView {
 		m.ShowHelpModal = !m.ShowHelpModal
 		return m, nil
 	}
@@ -58,6 +60,26 @@ func (h *KeyHandler) HandleKeyPress(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd
 		return h.handleDetailsViewKeys(m, msg)
 	case ActionConfigView:
 		return h.handleActionConfigViewKeys(m, msg)
+	case LogView:
+		return h.handleLogViewKeys(m, msg)
+	case DiffView:
+		return h.handleDiffViewKeys(m, msg)
+	case CommitView:
+		return h.handleCommitViewKeys(m, msg)
+	case RemoteView:
+		return h.handleRemoteViewKeys(m, msg)
+	case BulkView:
+		return h.handleBulkViewKeys(m, msg)
+	case BranchView:
+		return h.handleBranchViewKeys(m, msg)
+	case CleanupView:
+		return h.handleCleanupViewKeys(m, msg)
+	case WorktreeView:
+		return h.handleWorktreeViewKeys(m, msg)
+	case WorktreeListView:
+		return h.handleWorktreeListViewKeys(m, msg)
+	case ScanView:
+		return h.handleScanViewKeys(m, msg)
 	default:
 		return m, nil
 	}
@@ -79,6 +101,10 @@ func (h *KeyHandler) handleHelpModalKeys(m Model, msg tea.KeyMsg) (tea.Model, te
 func (h *KeyHandler) handleListViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	keyStr := msg.String()
 
+	if m.SelectMode {
+		return h.handleSelectModeKeys(m, keyStr)
+	}
+
 	switch keyStr {
 	case "ctrl+c", "q":
 		return m, tea.Quit
@@ -92,17 +118,54 @@ func (h *KeyHandler) handleListViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea
 		if m.Cursor < len(navigableItems) {
 			selectedItem := navigableItems[m.Cursor]
 			if selectedItem.Type == "repository" || selectedItem.Type == "worktree" {
-				m.State = DetailsView
-				m.SelectedNavItem = &selectedItem
+				return m.openDetails(selectedItem)
 			}
 		}
 		return m, nil
 	case "s":
 		return h.enterSettingsMode(m), nil
+	case "C":
+		navigableItems := m.getNavigableItems()
+		if m.Cursor < len(navigableItems) {
+			return m.openCommit(navigableItems[m.Cursor])
+		}
+		return m, nil
+	case "B":
+		navigableItems := m.getNavigableItems()
+		if m.Cursor < len(navigableItems) {
+			return m.openBranches(navigableItems[m.Cursor])
+		}
+		return m, nil
+	case "P", "U":
+		navigableItems := m.getNavigableItems()
+		if m.Cursor < len(navigableItems) {
+			return m.startRemote(navigableItems[m.Cursor], remoteActionForKey(keyStr))
+		}
+		return m, nil
+	case "v":
+		return m.toggleSelectMode(), nil
+	case "X":
+		return m.openCleanup()
+	case "S":
+		return m.openScan(), nil
 	case "w":
-		return h.discoverWorktrees(m)
+		navigableItems := m.getNavigableItems()
+		if m.Cursor < len(navigableItems) {
+			return m.openWorktreeDialog(navigableItems[m.Cursor])
+		}
+		return m, nil
+	case "W":
+		navigableItems := m.getNavigableItems()
+		if m.Cursor < len(navigableItems) {
+			return m.openWorktreeList(navigableItems[m.Cursor]), nil
+		}
+		return m, nil
 	case "r":
-		return m, m.updateRepositoryStatuses()
+		return m.requestRefresh()
+	case "p":
+		return m.toggleRefreshPause(), nil
+	case "o":
+		return m.toggleSortMode(), nil
 	case "e":
 		return h.openInFileManager(m)
 	case "?":
@@ -113,6 +176,50 @@ func (h *KeyHandler) handleListViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea
 	}
 }
 
+// handleSelectModeKeys handles key events while marking items for a bulk operation.
+// Configured actions run on the marked items instead of the one under the cursor.
+func (h *KeyHandler) handleSelectModeKeys(m Model, keyStr string) (tea.Model, tea.Cmd) {
+	switch keyStr {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "up", "k":
+		return h.navigationHandler.MoveCursorUp(m), nil
+	case "down", "j":
+		return h.navigationHandler.MoveCursorDown(m), nil
+	case "esc", "v":
+		return m.toggleSelectMode(), nil
+	case " ":
+		navigableItems := m.getNavigableItems()
+		if m.Cursor < len(navigableItems) {
+			return m.toggleMark(navigableItems[m.Cursor]), nil
+		}
+		return m, nil
+	case "a":
+		return m.markMatching(markAll), nil
+	case "n":
+		return m.clearMarks(), nil
+	case "d":
+		return m.markMatching(markDirty), nil
+	case "u":
+		return m.markMatching(markUnpushed), nil
+	case "b":
+		return m.markMatching(markBehind), nil
+	case "f":
+		return m.startBulkGit("fetch")
+	case "P", "U":
+		return m.startBulkGit(remoteActionForKey(keyStr))
+	case "r":
+		return m.requestRefresh()
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+
+	if action := m.Config.Keybindings.FindActionByKey(keyStr); action != nil {
+		return m.startBulkAction(*action)
+	}
+	return m, nil
+}
+
 // handleSettingsViewKeys handles key events in settings view.
 func (h *KeyHandler) handleSettingsViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	keyStr := msg.String()
@@ -156,8 +263,7 @@ func (h *KeyHandler) handleSettingsViewKeys(m Model, msg tea.KeyMsg) (tea.Model,
 		case "actions":
 			maxItems = len(m.Config.Keybindings.Actions) - 1
 		case "theme":
-			// Count total theme items - hardcoded for now as 32 items
-			maxItems = 31 // 0-indexed, so 32 items = max index 31
+			maxItems = len(h.getAllThemeItems(m.Config.Theme)) - 1
 		default: // repositories
 			maxItems = len(m.Dependencies.GetRepoManager().GetItems()) - 1
 		}
@@ -248,7 +354,7 @@ func (h *KeyHandler) handleSettingsViewKeys(m Model, msg tea.KeyMsg) (tea.Model,
 		}
 		return m, nil
 	case "r":
-		return m, m.updateRepositoryStatuses()
+		return m.requestRefresh()
 	case "q":
 		return m, tea.Quit
 	case "?":
@@ -262,7 +368,65 @@ func (h *KeyHandler) handleSettingsViewKeys(m Model, msg tea.KeyMsg) (tea.Model,
 func (h *KeyHandler) handleDetailsViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	keyStr := msg.String()
 
+	if m.StashDropConfirm {
+		m.StashDropConfirm = false
+		if keyStr == "y" {
+			return m.runStashAction("drop")
+		}
+		m.StashStatus = ""
+		return m, nil
+	}
+
+	// Discarded changes cannot be recovered, so they need an explicit confirmation
+	if m.DiscardConfirm {
+		return m.handleDiscardConfirm(keyStr)
+	}
+
 	switch keyStr {
+	case "up", "k":
+		if m.DetailsFocus != detailsFocusStashes {
+			return m.moveFileCursor(-1), nil
+		}
+		if m.StashCursor > 0 {
+			m.StashCursor--
+		}
+		return m, nil
+	case "down", "j":
+		if m.DetailsFocus != detailsFocusStashes {
+			return m.moveFileCursor(1), nil
+		}
+		if m.StashCursor < len(m.Stashes)-1 {
+			m.StashCursor++
+		}
+		return m, nil
+	case "tab":
+		return m.toggleDetailsFocus(), nil
+	case "a":
+		return m.runStashAction("apply")
+	case "p":
+		return m.runStashAction("pop")
+	case "d":
+		return m.confirmStashDrop(), nil
+	case "l":
+		return m.openLog()
+	case "s":
+		return m.runFileAction("stage")
+	case "u":
+		return m.runFileAction("unstage")
+	case "x":
+		return m.confirmDiscard(), nil
+	case "v":
+		return m.openDiff(false)
+	case "V":
+		return m.openDiff(true)
+	case "C":
+		return m.openCommit(*m.SelectedNavItem)
+	case "B":
+		return m.openBranches(*m.SelectedNavItem)
+	case "W":
+		return m.openWorktreeList(*m.SelectedNavItem), nil
+	case "P", "U":
+		return m.startRemote(*m.SelectedNavItem, remoteActionForKey(keyStr))
 	case "ctrl+c", "q":
 		return m, tea.Quit
 	case "b", "esc":
@@ -274,9 +438,380 @@ func (h *KeyHandler) handleDetailsViewKeys(m Model, msg tea.KeyMsg) (tea.Model,
 		return h.toggleHelpModal(m), nil
 	}
 
+	// Checked last, so an editor key that clashes with a built-in key cannot disable it
+	if keyStr == m.Config.Keybindings.EditorAction().Key {
+		return m.openFileInEditor()
+	}
+	return m, nil
+}
+
+// handleLogViewKeys handles key events in the commit log and the commit view.
+func (h *KeyHandler) handleLogViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	if m.LogDetail != nil {
+		switch keyStr {
+		case "up", "k":
+			m.LogDetailOffset = max(m.LogDetailOffset-1, 0)
+		case "down", "j":
+			m.LogDetailOffset = min(m.LogDetailOffset+1, commitlog.MaxCommitOffset(*m.LogDetail, m.Height))
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "b", "esc":
+			m.LogDetail = nil
+		}
+		return m, nil
+	}
+
+	page := commitlog.VisibleRows(m.Height)
+	switch keyStr {
+	case "up", "k":
+		return m.moveLogCursor(-1)
+	case "down", "j":
+		return m.moveLogCursor(1)
+	case "pgup", "ctrl+u":
+		return m.moveLogCursor(-page)
+	case "pgdown", "ctrl+d":
+		return m.moveLogCursor(page)
+	case "g", "home":
+		return m.moveLogCursor(-len(m.LogEntries))
+	case "G", "end":
+		return m.moveLogCursor(len(m.LogEntries))
+	case "enter":
+		return m.openCommitDetail()
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		m.State = DetailsView
+		m.LogEntries = nil
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// handleDiffViewKeys handles key events in the diff view.
+func (h *KeyHandler) handleDiffViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	page := diff.VisibleRows(m.Height)
+	keyStr := msg.String()
+
+	if m.DiscardConfirm {
+		return m.handleDiscardConfirm(keyStr)
+	}
+
+	switch keyStr {
+	case "up", "k":
+		return m.scrollDiff(-1), nil
+	case "down", "j":
+		return m.scrollDiff(1), nil
+	case "pgup", "ctrl+u":
+		return m.scrollDiff(-page), nil
+	case "pgdown", "ctrl+d", " ":
+		return m.scrollDiff(page), nil
+	case "g", "home":
+		return m.scrollDiff(-len(m.Diff.Lines)), nil
+	case "G", "end":
+		return m.scrollDiff(len(m.Diff.Lines)), nil
+	case "n", "]":
+		return m.jumpToHunk(1), nil
+	case "N", "[":
+		return m.jumpToHunk(-1), nil
+	case "left", "h":
+		return m.scrollDiffColumn(-diffColumnStep), nil
+	case "right", "l":
+		return m.scrollDiffColumn(diffColumnStep), nil
+	case "w":
+		m.DiffWrap = !m.DiffWrap
+		m.DiffColumn = 0
+		return m, nil
+	case "tab":
+		return m.toggleDiffStaged()
+	case "s":
+		return m.runHunkAction("stage")
+	case "u":
+		return m.runHunkAction("unstage")
+	case "x":
+		return m.confirmDiscard(), nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		return m.closeDiff(), nil
+	}
+
+	return m, nil
+}
+
+// handleCommitViewKeys handles key events in the commit composer. Keys without a
+// binding are typed into the message.
+func (h *KeyHandler) handleCommitViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		if m.CommitRunning {
+			return m, nil
+		}
+		return m.closeCommit(), nil
+	case "ctrl+s":
+		return m.runCommit()
+	case "ctrl+a":
+		return m.toggleCommitAmend()
+	case "ctrl+o":
+		m.CommitSignOff = !m.CommitSignOff
+		return m, nil
+	}
+
+	return m.editCommitMessage(msg), nil
+}
+
+// handleRemoteViewKeys handles key events on the push and pull page.
+func (h *KeyHandler) handleRemoteViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "x":
+		return m.cancelRemote(), nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		return m.closeRemote(), nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+	return m, nil
+}
+
+// handleBulkViewKeys handles key events on the bulk operation results page.
+func (h *KeyHandler) handleBulkViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		return m.scrollBulk(-1), nil
+	case "down", "j":
+		return m.scrollBulk(1), nil
+	case "x":
+		return m.cancelBulk(), nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		m.State = ListView
+		return m, nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+	return m, nil
+}
+
+// handleBranchViewKeys handles key events on the branch page.
+func (h *KeyHandler) handleBranchViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	if m.BranchInputAction != "" {
+		switch keyStr {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			return m.cancelBranchInput(), nil
+		case "enter":
+			return m.submitBranchInput()
+		}
+		return m.editBranchInput(msg), nil
+	}
+
+	if m.BranchDeleteConfirm {
+		return m.handleBranchDeleteConfirm(keyStr)
+	}
+
+	switch keyStr {
+	case "up", "k":
+		return m.moveBranchCursor(-1), nil
+	case "down", "j":
+		return m.moveBranchCursor(1), nil
+	case "enter":
+		return m.checkoutBranch()
+	case "n":
+		return m.startBranchInput(branchInputCreate), nil
+	case "r":
+		return m.startBranchInput(branchInputRename), nil
+	case "d":
+		return m.confirmBranchDelete(), nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		return m.closeBranches(), nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
 	return m, nil
 }
 
+// handleWorktreeViewKeys handles key events in the new worktree dialog.
+func (h *KeyHandler) handleWorktreeViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	// While a branch name or path is typed every key edits it
+	if m.WorktreeInputAction != "" {
+		switch keyStr {
+		case "enter":
+			return m.submitWorktreeInput()
+		case "esc":
+			return m.cancelWorktreeInput(), nil
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+		return m.editWorktreeInput(msg), nil
+	}
+
+	switch keyStr {
+	case "up", "k":
+		return m.moveWorktreeCursor(-1), nil
+	case "down", "j":
+		return m.moveWorktreeCursor(1), nil
+	case "enter":
+		return m.useWorktreeBranch(), nil
+	case "n":
+		return m.startWorktreeBranchInput(), nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		return m.closeWorktreeDialog(), nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+	return m, nil
+}
+
+// handleWorktreeListViewKeys handles key events on the worktree list.
+func (h *KeyHandler) handleWorktreeListViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	// While a lock reason is typed every key edits it
+	if m.WorktreeLocking {
+		switch keyStr {
+		case "enter":
+			return m.submitWorktreeLock()
+		case "esc":
+			return m.cancelWorktreeLock(), nil
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+		return m.editWorktreeLockInput(msg), nil
+	}
+
+	if m.WorktreeRemoveConfirm {
+		return m.handleWorktreeRemoveConfirm(keyStr)
+	}
+
+	switch keyStr {
+	case "up", "k":
+		return m.moveWorktreeListCursor(-1), nil
+	case "down", "j":
+		return m.moveWorktreeListCursor(1), nil
+	case "d":
+		return m.confirmWorktreeRemove(), nil
+	case "p":
+		return m.pruneWorktrees()
+	case "L":
+		return m.toggleWorktreeLock()
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		return m.closeWorktreeList(), nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+	return m, nil
+}
+
+// handleCleanupViewKeys handles key events on the branch cleanup report.
+func (h *KeyHandler) handleCleanupViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	// Deleted branches cannot be restored from here, so the confirmation screen
+	// only continues on an explicit y
+	if m.CleanupConfirm {
+		return m.handleCleanupConfirm(keyStr)
+	}
+
+	switch keyStr {
+	case "up", "k":
+		return m.moveCleanupCursor(-1), nil
+	case "down", "j":
+		return m.moveCleanupCursor(1), nil
+	case " ":
+		return m.toggleCleanupCheck(), nil
+	case "a":
+		return m.checkAllCleanup(true), nil
+	case "n":
+		return m.checkAllCleanup(false), nil
+	case "d":
+		return m.confirmCleanup(), nil
+	case "r":
+		return m.reloadCleanup()
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		m.State = ListView
+		return m, nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+	return m, nil
+}
+
+// handleScanViewKeys handles key events on the repository scan page.
+func (h *KeyHandler) handleScanViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	keyStr := msg.String()
+
+	if m.ScanEditing {
+		switch keyStr {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "enter":
+			return m.startScan()
+		case "esc":
+			return m.cancelScanEdit(), nil
+		}
+		return m.editScanRoots(msg), nil
+	}
+
+	switch keyStr {
+	case "up", "k":
+		return m.moveScanCursor(-1), nil
+	case "down", "j":
+		return m.moveScanCursor(1), nil
+	case " ":
+		return m.toggleScanCheck(), nil
+	case "a":
+		return m.checkAllScan(true), nil
+	case "n":
+		return m.checkAllScan(false), nil
+	case "enter":
+		return m.addScanResults()
+	case "e":
+		if !m.ScanRunning {
+			m.ScanEditing = true
+		}
+		return m, nil
+	case "r":
+		return m.startScan()
+	case "x":
+		return m.cancelScan(), nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "b", "esc":
+		return m.closeScan(), nil
+	case "?":
+		return h.toggleHelpModal(m), nil
+	}
+	return m, nil
+}
+
+// remoteActionForKey returns the remote operation bound to P (push) or U (pull).
+func remoteActionForKey(key string) string {
+	if key == "P" {
+		return "push"
+	}
+	return "pull"
+}
+
 // handleActionConfigViewKeys handles key events in action configuration view.
 func (h *KeyHandler) handleActionConfigViewKeys(m Model, msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	keyStr := msg.String()
@@ -393,12 +928,6 @@ func (h *KeyHandler) toggleHelpModal(m Model) Model {
 	return m
 }
 
-// discoverWorktrees initiates worktree discovery.
-func (h *KeyHandler) discoverWorktrees(m Model) (Model, tea.Cmd) {
-	// Implementation would go here - placeholder for now
-	return m, nil
-}
-
 // executeConfiguredAction executes a configured action based on key binding.
 func (h *KeyHandler) executeConfiguredAction(m Model, keyStr string) (tea.Model, tea.Cmd) {
 	// Check for configurable actions
@@ -757,12 +1286,36 @@ func (h *KeyHandler) saveThemePropertyEdit(m Model) error {
 		m.Config.Theme.Colors.IconBare = m.ThemeEditValue
 	case "Worktree Icon":
 		m.Config.Theme.Colors.IconWorktree = m.ThemeEditValue
+	case "Diff Added":
+		m.Config.Theme.Colors.DiffAdded = m.ThemeEditValue
+	case "Diff Removed":
+		m.Config.Theme.Colors.DiffRemoved = m.ThemeEditValue
+	case "Diff Hunk":
+		m.Config.Theme.Colors.DiffHunk = m.ThemeEditValue
+	case "Diff Header":
+		m.Config.Theme.Colors.DiffHeader = m.ThemeEditValue
 	case "Clean Status Color":
 		m.Config.Theme.Colors.StatusClean = m.ThemeEditValue
 	case "Dirty Status Color":
 		m.Config.Theme.Colors.StatusDirty = m.ThemeEditValue
 	case "Unpushed Status Color":
 		m.Config.Theme.Colors.StatusUnpushed = m.ThemeEditValue
+	case "Behind Status Color":
+		m.Config.Theme.Colors.StatusBehind = m.ThemeEditValue
+	case "Stale Fetch Color":
+		m.Config.Theme.Colors.StatusStale = m.ThemeEditValue
+	case "Stash Status Color":
+		m.Config.Theme.Colors.StatusStash = m.ThemeEditValue
+	case "Merge In Progress Color":
+		m.Config.Theme.Colors.StatusMerging = m.ThemeEditValue
+	case "Rebase In Progress Color":
+		m.Config.Theme.Colors.StatusRebasing = m.ThemeEditValue
+	case "Cherry-pick In Progress Color":
+		m.Config.Theme.Colors.StatusCherryPicking = m.ThemeEditValue
+	case "Revert In Progress Color":
+		m.Config.Theme.Colors.StatusReverting = m.ThemeEditValue
+	case "Bisect In Progress Color":
+		m.Config.Theme.Colors.StatusBisecting = m.ThemeEditValue
 	case "Untracked Status Color":
 		m.Config.Theme.Colors.StatusUntracked = m.ThemeEditValue
 	case "Error Status Color":
@@ -777,6 +1330,26 @@ func (h *KeyHandler) saveThemePropertyEdit(m Model) error {
 		m.Config.Theme.Indicators.Dirty = m.ThemeEditValue
 	case "Unpushed Status Icon":
 		m.Config.Theme.Indicators.Unpushed = m.ThemeEditValue
+	case "Behind Status Icon":
+		m.Config.Theme.Indicators.Behind = m.ThemeEditValue
+	case "Stale Fetch Icon":
+		m.Config.Theme.Indicators.Stale = m.ThemeEditValue
+	case "Stash Status Icon":
+		m.Config.Theme.Indicators.Stash = m.ThemeEditValue
+	case "Locked Worktree Icon":
+		m.Config.Theme.Indicators.Locked = m.ThemeEditValue
+	case "Prunable Worktree Icon":
+		m.Config.Theme.Indicators.Prunable = m.ThemeEditValue
+	case "Merge In Progress Icon":
+		m.Config.Theme.Indicators.Merging = m.ThemeEditValue
+	case "Rebase In Progress Icon":
+		m.Config.Theme.Indicators.Rebasing = m.ThemeEditValue
+	case "Cherry-pick In Progress Icon":
+		m.Config.Theme.Indicators.CherryPicking = m.ThemeEditValue
+	case "Revert In Progress Icon":
+		m.Config.Theme.Indicators.Reverting = m.ThemeEditValue
+	case "Bisect In Progress Icon":
+		m.Config.Theme.Indicators.Bisecting = m.ThemeEditValue
 	case "Untracked Status Icon":
 		m.Config.Theme.Indicators.Untracked = m.ThemeEditValue
 	case "Error Status Icon":
@@ -787,6 +1360,8 @@ func (h *KeyHandler) saveThemePropertyEdit(m Model) error {
 		m.Config.Theme.Indicators.Selected = m.ThemeEditValue
 	case "Selected End":
 		m.Config.Theme.Indicators.SelectedEnd = m.ThemeEditValue
+	case "Marked Indicator":
+		m.Config.Theme.Indicators.Marked = m.ThemeEditValue
 
 	// Icons
 	case "Regular Repository":
@@ -834,6 +1409,10 @@ func (h *KeyHandler) getAllThemeItems(themeConfig theme.Theme) []ThemeItem {
 		{"Regular Icon", themeConfig.Colors.IconRegular, "color", "Colors"},
 		{"Bare Icon", themeConfig.Colors.IconBare, "color", "Colors"},
 		{"Worktree Icon", themeConfig.Colors.IconWorktree, "color", "Colors"},
+		{"Diff Added", themeConfig.Colors.DiffAdded, "color", "Colors"},
+		{"Diff Removed", themeConfig.Colors.DiffRemoved, "color", "Colors"},
+		{"Diff Hunk", themeConfig.Colors.DiffHunk, "color", "Colors"},
+		{"Diff Header", themeConfig.Colors.DiffHeader, "color", "Colors"},
 	}...)
 
 	// Status colors and indicators
@@ -844,6 +1423,24 @@ func (h *KeyHandler) getAllThemeItems(themeConfig theme.Theme) []ThemeItem {
 		{"Dirty Status Icon", themeConfig.Indicators.Dirty, "indicator", "Status Indicators"},
 		{"Unpushed Status Color", themeConfig.Colors.StatusUnpushed, "color", "Status Indicators"},
 		{"Unpushed Status Icon", themeConfig.Indicators.Unpushed, "indicator", "Status Indicators"},
+		{"Behind Status Color", themeConfig.Colors.StatusBehind, "color", "Status Indicators"},
+		{"Behind Status Icon", themeConfig.Indicators.Behind, "indicator", "Status Indicators"},
+		{"Stale Fetch Color", themeConfig.Colors.StatusStale, "color", "Status Indicators"},
+		{"Stale Fetch Icon", themeConfig.Indicators.Stale, "indicator", "Status Indicators"},
+		{"Stash Status Color", themeConfig.Colors.StatusStash, "color", "Status Indicators"},
+		{"Stash Status Icon", themeConfig.Indicators.Stash, "indicator", "Status Indicators"},
+		{"Locked Worktree Icon", themeConfig.Indicators.Locked, "indicator", "Status Indicators"},
+		{"Prunable Worktree Icon", themeConfig.Indicators.Prunable, "indicator", "Status Indicators"},
+		{"Merge In Progress Color", themeConfig.Colors.StatusMerging, "color", "Status Indicators"},
+		{"Merge In Progress Icon", themeConfig.Indicators.Merging, "indicator", "Status Indicators"},
+		{"Rebase In Progress Color", themeConfig.Colors.StatusRebasing, "color", "Status Indicators"},
+		{"Rebase In Progress Icon", themeConfig.Indicators.Rebasing, "indicator", "Status Indicators"},
+		{"Cherry-pick In Progress Color", themeConfig.Colors.StatusCherryPicking, "color", "Status Indicators"},
+		{"Cherry-pick In Progress Icon", themeConfig.Indicators.CherryPicking, "indicator", "Status Indicators"},
+		{"Revert In Progress Color", themeConfig.Colors.StatusReverting, "color", "Status Indicators"},
+		{"Revert In Progress Icon", themeConfig.Indicators.Reverting, "indicator", "Status Indicators"},
+		{"Bisect In Progress Color", themeConfig.Colors.StatusBisecting, "color", "Status Indicators"},
+		{"Bisect In Progress Icon", themeConfig.Indicators.Bisecting, "indicator", "Status Indicators"},
 		{"Untracked Status Color", themeConfig.Colors.StatusUntracked, "color", "Status Indicators"},
 		{"Untracked Status Icon", themeConfig.Indicators.Untracked, "indicator", "Status Indicators"},
 		{"Error Status Color", themeConfig.Colors.StatusError, "color", "Status Indicators"},
@@ -863,6 +1460,7 @@ func (h *KeyHandler) getAllThemeItems(themeConfig theme.Theme) []ThemeItem {
 	items = append(items, []ThemeItem{
 		{"Selected Indicator", themeConfig.Indicators.Selected, "indicator", "UI Icons"},
 		{"Selected End", themeConfig.Indicators.SelectedEnd, "indicator", "UI Icons"},
+		{"Marked Indicator", themeConfig.Indicators.Marked, "indicator", "UI Icons"},
 		{"Branch Icon", themeConfig.Icons.Branch.Icon, "icon", "UI Icons"},
 		{"Tree Branch", themeConfig.Icons.Tree.Branch, "icon", "UI Icons"},
 		{"Tree Last", themeConfig.Icons.Tree.Last, "icon", "UI Icons"},
@@ -989,7 +1587,7 @@ func (h *KeyHandler) addRepositoryFromPath(m Model, path string) (Model, tea.Cmd
 	h.updateExplorerRepositoryList(m)
 
 	// Update repository list
-	return m, m.updateRepositoryStatuses()
+	return m.requestRefresh()
 }
 
 // handleRepositoryUpNavigation handles up navigation in repository sections
@@ -1044,8 +1642,7 @@ func (h *KeyHandler) handleRepositoryEnterNavigation(m Model) (Model, tea.Cmd) {
 		if m.SettingsCursor < len(navigableItems) {
 			selectedItem := navigableItems[m.SettingsCursor]
 			if selectedItem.Type == "repository" || selectedItem.Type == "worktree" {
-				m.State = DetailsView
-				m.SelectedNavItem = &selectedItem
+				return m.openDetails(selectedItem)
 			}
 		}
 	case "explorer":
@@ -1102,7 +1699,7 @@ func (h *KeyHandler) removeRepositoryFromPath(m Model, path string) (Model, tea.
 	h.updateExplorerRepositoryList(m)
 
 	// Update repository list
-	return m, m.updateRepositoryStatuses()
+	return m.requestRefresh()
 }
 
 // openInFileManager opens the selected repository path in the system file manager
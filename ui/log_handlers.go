@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/commitlog"
+)
+
+// logPageSize is the number of commits read at once when paging through history.
+const logPageSize = 100
+
+// LogLoaded carries a page of the commit log of the selected item.
+type LogLoaded struct {
+	Path string
+	Page repomanager.LogPage
+	Err  error
+}
+
+// CommitDetailLoaded carries the full message and file statistics of a commit.
+type CommitDetailLoaded struct {
+	Path   string
+	Detail repomanager.CommitDetail
+	Err    error
+}
+
+// openLog switches to the commit log of the item shown in the details view.
+func (m Model) openLog() (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil {
+		return m, nil
+	}
+
+	m.State = LogView
+	m.LogEntries = nil
+	m.LogHasMore = false
+	m.LogLimit = logPageSize
+	m.LogLoading = true
+	m.LogCursor = 0
+	m.LogOffset = 0
+	m.LogStatus = ""
+	m.LogDetail = nil
+	return m, m.loadLog(m.SelectedNavItem.Path(), m.LogLimit)
+}
+
+// loadLog reads the history of the repository at path up to limit commits.
+func (m Model) loadLog(path string, limit int) tea.Cmd {
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return func() tea.Msg {
+		page, err := repoManager.ReadLog(path, limit)
+		return LogLoaded{Path: path, Page: page, Err: err}
+	}
+}
+
+// handleLogLoaded stores a log page if the log of the same item is still open.
+// A longer page replaces the loaded one, so the cursor keeps pointing at the same line.
+func (m Model) handleLogLoaded(msg LogLoaded) (tea.Model, tea.Cmd) {
+	if m.State != LogView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	m.LogLoading = false
+	if msg.Err != nil {
+		m.LogStatus = "Failed to read log: " + msg.Err.Error()
+		return m, nil
+	}
+
+	m.LogEntries = msg.Page.Entries
+	m.LogHasMore = msg.Page.HasMore
+	if m.LogCursor >= len(m.LogEntries) || !m.LogEntries[m.LogCursor].IsCommit() {
+		return m.moveLogCursor(0)
+	}
+	return m, nil
+}
+
+// moveLogCursor moves the selection by delta lines, skipping graph-only lines and
+// loading older commits once the cursor gets close to the end of the loaded history.
+func (m Model) moveLogCursor(delta int) (Model, tea.Cmd) {
+	if len(m.LogEntries) == 0 {
+		return m, nil
+	}
+
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+	visible := commitlog.VisibleRows(m.Height)
+	target, _ := scrollWindow(m.LogCursor, m.LogOffset, delta, len(m.LogEntries), visible)
+	// Skipping graph-only lines may leave the window, so scroll to the commit found
+	m.LogCursor, m.LogOffset = scrollWindow(nearestCommit(m.LogEntries, target, step), m.LogOffset, 0, len(m.LogEntries), visible)
+
+	if m.LogHasMore && !m.LogLoading && m.LogCursor >= len(m.LogEntries)-visible {
+		m.LogLoading = true
+		m.LogLimit += logPageSize
+		return m, m.loadLog(m.SelectedNavItem.Path(), m.LogLimit)
+	}
+	return m, nil
+}
+
+// nearestCommit returns the index of the commit closest to index, searching in the
+// direction of step first.
+func nearestCommit(entries []repomanager.LogEntry, index, step int) int {
+	for _, direction := range []int{step, -step} {
+		for i := index; i >= 0 && i < len(entries); i += direction {
+			if entries[i].IsCommit() {
+				return i
+			}
+		}
+	}
+	return index
+}
+
+// openCommitDetail loads the full message and file statistics of the selected commit.
+func (m Model) openCommitDetail() (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.LogCursor >= len(m.LogEntries) || !m.LogEntries[m.LogCursor].IsCommit() {
+		return m, nil
+	}
+
+	path := m.SelectedNavItem.Path()
+	hash := m.LogEntries[m.LogCursor].Hash
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return m, func() tea.Msg {
+		detail, err := repoManager.ReadCommitDetail(path, hash)
+		return CommitDetailLoaded{Path: path, Detail: detail, Err: err}
+	}
+}
+
+// handleCommitDetailLoaded shows the loaded commit if the log of the same item is still open.
+func (m Model) handleCommitDetailLoaded(msg CommitDetailLoaded) (tea.Model, tea.Cmd) {
+	if m.State != LogView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	if msg.Err != nil {
+		m.LogStatus = "Failed to read commit: " + msg.Err.Error()
+		return m, nil
+	}
+
+	m.LogStatus = ""
+	m.LogDetail = &msg.Detail
+	m.LogDetailOffset = 0
+	return m, nil
+}
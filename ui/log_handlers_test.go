@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+func TestMoveLogCursor_SkipsGraphLines(t *testing.T) {
+	m := Model{
+		Height: 40,
+		LogEntries: []repomanager.LogEntry{
+			{Graph: "*   ", Hash: "merge"},
+			{Graph: "|\\  "},
+			{Graph: "| * ", Hash: "side"},
+			{Graph: "|/  "},
+			{Graph: "*   ", Hash: "base"},
+		},
+	}
+
+	steps := []struct {
+		delta int
+		want  int
+	}{
+		{1, 2},
+		{1, 4},
+		{1, 4},
+		{-1, 2},
+		{-10, 0},
+		{10, 4},
+	}
+	for _, step := range steps {
+		m, _ = m.moveLogCursor(step.delta)
+		if m.LogCursor != step.want {
+			t.Fatalf("after moving %d the cursor is at %d, want %d", step.delta, m.LogCursor, step.want)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package ui
 
 import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jarmocluyse/git-dash/internal/config"
 	"github.com/jarmocluyse/git-dash/internal/repomanager"
@@ -17,6 +21,16 @@ const (
 	SettingsView
 	DetailsView
 	ActionConfigView
+	LogView
+	DiffView
+	CommitView
+	RemoteView
+	BulkView
+	BranchView
+	CleanupView
+	WorktreeView
+	WorktreeListView
+	ScanView
 )
 
 // Dependencies interface defines what the UI needs from the application layer
@@ -43,6 +57,125 @@ type Model struct {
 	NavItemsNeedSync bool                  // Flag to indicate cache needs update
 	SelectedNavItem  *types.NavigableItem  // Currently selected item for details view
 
+	// Changed-files section of the details view
+	Files        []repomanager.ChangedFile // Changed files of the selected item, sorted by group
+	FileCursor   int                       // Selected changed file
+	FileOffset   int                       // First visible row of the changed-files list
+	FileStatus   string                    // Result of the last file action, read or editor run
+	DetailsFocus string                    // Section the cursor keys move through: "files" or "stashes"
+
+	// Stash section of the details view
+	Stashes          []repomanager.StashEntry // Stash list of the selected item
+	StashCursor      int                      // Selected stash entry
+	StashStatus      string                   // Result of the last stash action
+	StashDropConfirm bool                     // Whether a drop is waiting for confirmation
+
+	// Diff page of the selected item
+	DiffOptions repomanager.DiffOptions // File and side being compared
+	Diff        repomanager.Diff        // Loaded diff
+	DiffOffset  int                     // First visible diff line
+	DiffColumn  int                     // First visible column when lines are not wrapped
+	DiffWrap    bool                    // Whether long lines wrap instead of scrolling sideways
+	DiffLoading bool                    // Whether the diff is being read
+	DiffStatus  string                  // Error of the last diff read or result of the last hunk action
+
+	DiscardConfirm bool // Whether discarding a file or hunk is waiting for confirmation
+
+	// Commit composer of the selected item, which commits the staged part of Files
+	CommitMessage     string    // Message being edited, may span several lines
+	CommitAmend       bool      // Whether the commit replaces HEAD
+	CommitSignOff     bool      // Whether a Signed-off-by trailer is added
+	CommitRunning     bool      // Whether git commit is running
+	CommitStatus      string    // Git error of the last attempt or a validation hint
+	CommitReturnState ViewState // View the composer was opened from
+
+	// Push or pull page, whose output is streamed while the command runs
+	RemoteName        string                   // Name of the repository or worktree being pushed or pulled
+	RemoteAction      string                   // "push" or "pull"
+	RemoteOutput      []repomanager.OutputLine // Output printed so far, progress lines replaced in place
+	RemoteRunning     bool                     // Whether the command is still running
+	RemoteFailed      bool                     // Whether the finished command failed or was cancelled
+	RemoteStatus      string                   // Result of the finished command
+	RemoteEvents      <-chan tea.Msg           // Output and completion of the running command
+	RemoteCancel      context.CancelFunc       // Stops the running command
+	RemoteReturnState ViewState                // View the page was opened from
+
+	// Branch page of the selected item
+	Branches            []repomanager.Branch // Local branches followed by remote-tracking branches
+	BranchCursor        int                  // Selected branch
+	BranchOffset        int                  // First visible branch
+	BranchLoading       bool                 // Whether the branches are being read
+	BranchStatus        string               // Result of the last action or a confirmation question
+	BranchInputAction   string               // "create" or "rename" while a branch name is typed
+	BranchInput         string               // Branch name typed so far
+	BranchDeleteConfirm bool                 // Whether deleting the selected branch is waiting for confirmation
+	BranchReturnState   ViewState            // View the page was opened from
+
+	// Branch cleanup report across all repositories
+	CleanupBranches  []repomanager.CleanupBranch // Merged, gone and stale branches
+	CleanupChecked   []bool                      // Whether each branch is checked for deletion
+	CleanupCursor    int                         // Selected branch
+	CleanupOffset    int                         // First visible branch
+	CleanupLoading   bool                        // Whether the report is being read
+	CleanupStaleDays int                         // Days without commits after which a branch is listed
+	CleanupStatus    string                      // Problems reading the report or a hint
+	CleanupConfirm   bool                        // Whether the confirmation screen is shown
+
+	// Dialog creating a worktree for the selected repository
+	WorktreeBranches    []repomanager.Branch // Branches to create the worktree from
+	WorktreeCursor      int                  // Selected branch
+	WorktreeOffset      int                  // First visible branch
+	WorktreeLoading     bool                 // Whether the branches are being read
+	WorktreeInputAction string               // "branch" or "path" while one is typed
+	WorktreeInput       string               // Branch name or path typed so far
+	WorktreeNewBranch   string               // Name of the branch created for the worktree, empty to use the selected one
+	WorktreeRunning     bool                 // Whether git worktree add is running
+	WorktreeStatus      string               // Why the last attempt failed or a hint
+
+	// Worktree list of a repository with remove, prune and lock actions
+	WorktreeRepo            *repomanager.RepoItem // Repository whose worktrees are listed
+	WorktreeListCursor      int                   // Selected worktree
+	WorktreeListOffset      int                   // First visible worktree
+	WorktreeListStatus      string                // Result of the last action or a confirmation question
+	WorktreeRemoveConfirm   bool                  // Whether removing the selected worktree is waiting for confirmation
+	WorktreeLocking         bool                  // Whether a lock reason is typed
+	WorktreeLockInput       string                // Lock reason typed so far
+	WorktreeListReturnState ViewState             // View the list was opened from
+
+	// Repository discovery scan and the review of its results
+	ScanRoots   string                   // Directories to scan, separated by commas
+	ScanEditing bool                     // Whether the directories are being typed
+	ScanResults []repomanager.ScanResult // Repositories found by the last scan
+	ScanChecked []bool                   // Whether each result is checked for adding
+	ScanCursor  int                      // Selected result
+	ScanOffset  int                      // First visible result
+	ScanRunning bool                     // Whether the scan is running
+	ScanAdding  bool                     // Whether the checked results are being added
+	ScanScanned bool                     // Whether a scan finished since the page was opened
+	ScanStatus  string                   // Result of the last scan or add, or a hint
+	ScanCancel  context.CancelFunc       // Stops the running scan
+
+	// Multi-select mode of the list and the bulk operation page
+	SelectMode  bool               // Whether the list marks items instead of acting on the cursor
+	Marked      map[string]bool    // Paths of the marked repositories and worktrees
+	BulkAction  string             // Operation running on the marked items, e.g. "fetch"
+	BulkEntries []BulkEntry        // Per-item progress of the bulk operation, in list order
+	BulkOffset  int                // First visible entry of the results
+	BulkRunning bool               // Whether operations are still running
+	BulkEvents  <-chan tea.Msg     // Results and completion of the running bulk operation
+	BulkCancel  context.CancelFunc // Stops the bulk operation
+
+	// Commit log page of the selected item
+	LogEntries      []repomanager.LogEntry    // Loaded log lines, including graph-only lines
+	LogHasMore      bool                      // Whether older commits can still be loaded
+	LogLimit        int                       // Number of commits requested so far
+	LogLoading      bool                      // Whether a log page is being read
+	LogCursor       int                       // Selected log line, always a commit
+	LogOffset       int                       // First visible log line
+	LogStatus       string                    // Error of the last log read
+	LogDetail       *repomanager.CommitDetail // Commit shown in full, nil while browsing the log
+	LogDetailOffset int                       // First visible line of the commit view
+
 	// Action configuration fields
 	ActionConfigCursor   int            // Cursor for action list
 	ActionConfigEditMode bool           // Whether we're editing an action
@@ -71,6 +204,20 @@ type Model struct {
 	RepoPasteMode     bool                  // Whether paste input is active
 	RepoPasteValue    string                // Current paste input value
 
+	// Automatic refresh state
+	Refreshing    bool      // Whether a status refresh is currently running
+	RefreshQueued bool      // Whether another refresh was requested while one was running
+	RefreshPaused bool      // Whether automatic refreshes are paused
+	LastRefresh   time.Time // When the last status refresh finished
+
+	SortMode string // Home list order, one of the config.SortMode values
+
+	// Background fetching, nil when disabled in the configuration
+	FetchScheduler *repomanager.FetchScheduler
+
+	// Live filesystem watching, nil when disabled or unsupported
+	Watcher *repomanager.Watcher
+
 	// Handler instances for separated concerns
 	KeyHandler        *KeyHandler
 	NavigationHandler *NavigationHandler
@@ -78,25 +225,37 @@ type Model struct {
 }
 
 type StyleConfig struct {
-	Item              lipgloss.Style
-	SelectedItem      lipgloss.Style
-	StatusUncommitted lipgloss.Style
-	StatusUnpushed    lipgloss.Style
-	StatusUntracked   lipgloss.Style
-	StatusError       lipgloss.Style
-	StatusClean       lipgloss.Style
-	StatusNotAdded    lipgloss.Style
-	Input             lipgloss.Style
-	Help              lipgloss.Style
-	HelpModal         lipgloss.Style
-	HelpModalTitle    lipgloss.Style
-	HelpModalContent  lipgloss.Style
-	HelpModalFooter   lipgloss.Style
-	Branch            lipgloss.Style
-	Border            lipgloss.Style
-	IconRegular       lipgloss.Style
-	IconBare          lipgloss.Style
-	IconWorktree      lipgloss.Style
+	Item                lipgloss.Style
+	SelectedItem        lipgloss.Style
+	StatusUncommitted   lipgloss.Style
+	StatusUnpushed      lipgloss.Style
+	StatusBehind        lipgloss.Style
+	StatusStale         lipgloss.Style
+	StatusStash         lipgloss.Style
+	StatusMerging       lipgloss.Style
+	StatusRebasing      lipgloss.Style
+	StatusCherryPicking lipgloss.Style
+	StatusReverting     lipgloss.Style
+	StatusBisecting     lipgloss.Style
+	StatusUntracked     lipgloss.Style
+	StatusError         lipgloss.Style
+	StatusClean         lipgloss.Style
+	StatusNotAdded      lipgloss.Style
+	Input               lipgloss.Style
+	Help                lipgloss.Style
+	HelpModal           lipgloss.Style
+	HelpModalTitle      lipgloss.Style
+	HelpModalContent    lipgloss.Style
+	HelpModalFooter     lipgloss.Style
+	Branch              lipgloss.Style
+	Border              lipgloss.Style
+	IconRegular         lipgloss.Style
+	IconBare            lipgloss.Style
+	IconWorktree        lipgloss.Style
+	DiffAdded           lipgloss.Style
+	DiffRemoved         lipgloss.Style
+	DiffHunk            lipgloss.Style
+	DiffHeader          lipgloss.Style
 }
 
 // CreateStyleConfig creates a new StyleConfig using the provided theme configuration.
@@ -112,6 +271,28 @@ func CreateStyleConfig(themeConfig theme.Theme) StyleConfig {
 		StatusUnpushed: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(themeConfig.Colors.StatusUnpushed)).
 			Bold(true),
+		StatusBehind: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusBehind)).
+			Bold(true),
+		StatusStale: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusStale)),
+		StatusStash: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusStash)),
+		StatusMerging: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusMerging)).
+			Bold(true),
+		StatusRebasing: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusRebasing)).
+			Bold(true),
+		StatusCherryPicking: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusCherryPicking)).
+			Bold(true),
+		StatusReverting: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusReverting)).
+			Bold(true),
+		StatusBisecting: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusBisecting)).
+			Bold(true),
 		StatusUntracked: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(themeConfig.Colors.StatusUntracked)).
 			Bold(true),
@@ -167,5 +348,14 @@ func CreateStyleConfig(themeConfig theme.Theme) StyleConfig {
 		IconWorktree: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(themeConfig.Colors.IconWorktree)).
 			Bold(true),
+		DiffAdded: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.DiffAdded)),
+		DiffRemoved: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.DiffRemoved)),
+		DiffHunk: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.DiffHunk)),
+		DiffHeader: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.DiffHeader)).
+			Bold(true),
 	}
 }
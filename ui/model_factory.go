@@ -3,6 +3,8 @@ package ui
 import (
 	"github.com/charmbracelet/bubbletea"
 	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
 )
 
 // ModelFactory handles creation and initialization of UI models.
@@ -18,6 +20,18 @@ func (f *ModelFactory) CreateInitialModel(deps Dependencies) Model {
 	cfg := f.loadConfiguration(deps)
 
 	// The repository manager is already initialized in dependencies
+	var fetchScheduler *repomanager.FetchScheduler
+	if cfg.Fetch.Enabled {
+		fetchScheduler = repomanager.NewFetchScheduler(deps.GetRepoManager(), cfg.Fetch)
+	}
+
+	var watcher *repomanager.Watcher
+	if cfg.Watch.Enabled {
+		var err error
+		if watcher, err = repomanager.NewWatcher(deps.GetRepoManager(), cfg.Watch); err != nil {
+			logging.Get().Warn("live status updates disabled", "error", err)
+		}
+	}
 
 	return Model{
 		Dependencies:     deps,
@@ -25,6 +39,10 @@ func (f *ModelFactory) CreateInitialModel(deps Dependencies) Model {
 		State:            ListView,
 		Cursor:           0,
 		NavItemsNeedSync: true,
+		Refreshing:       true, // Init starts the first refresh
+		SortMode:         cfg.SortMode,
+		FetchScheduler:   fetchScheduler,
+		Watcher:          watcher,
 
 		// Initialize settings fields
 		SettingsSection: "repositories",
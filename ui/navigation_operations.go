@@ -1,6 +1,10 @@
 package ui
 
 import (
+	"slices"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
 	"github.com/jarmocluyse/git-dash/ui/layout"
 	"github.com/jarmocluyse/git-dash/ui/types"
 )
@@ -27,6 +31,18 @@ func (m Model) getVisibleItemCount() int {
 	return itemsPerScreen
 }
 
+// scrollWindow moves cursor by delta within n rows and returns it with the offset
+// of the first visible row, adjusted so the cursor stays in a window of visible rows.
+func scrollWindow(cursor, offset, delta, n, visible int) (int, int) {
+	cursor = min(max(cursor+delta, 0), max(n-1, 0))
+	if cursor < offset {
+		offset = cursor
+	} else if cursor >= offset+visible {
+		offset = cursor - visible + 1
+	}
+	return cursor, offset
+}
+
 // getNavigableItems returns cached navigable items or rebuilds if needed.
 func (m *Model) getNavigableItems() []types.NavigableItem {
 	if m.CachedNavItems == nil || m.NavItemsNeedSync {
@@ -36,15 +52,15 @@ func (m *Model) getNavigableItems() []types.NavigableItem {
 	return m.CachedNavItems
 }
 
-// rebuildNavigableItems rebuilds the cached navigable items using the repository manager.
+// rebuildNavigableItems rebuilds the cached navigable items from the items of the
+// repository manager. It runs while rendering, so it only reads what the background
+// refreshes already loaded.
 func (m *Model) rebuildNavigableItems() {
-	if err := m.Dependencies.GetRepoManager().ReloadWorktrees(); err != nil {
-		// Handle error if needed, for now just continue
-		return
-	}
-
 	// Get the repository items and build navigable items
 	repoItems := m.Dependencies.GetRepoManager().GetItems()
+	if m.SortMode == config.SortModeRecent {
+		repoItems = sortByRecentCommit(repoItems)
+	}
 
 	var items []types.NavigableItem
 	for _, repoItem := range repoItems {
@@ -56,8 +72,12 @@ func (m *Model) rebuildNavigableItems() {
 		items = append(items, repoNavItem)
 
 		// Add worktrees as separate navigable items
-		for i := range repoItem.SubItems {
-			subItem := repoItem.SubItems[i]
+		subItems := repoItem.SubItems
+		if m.SortMode == config.SortModeRecent {
+			subItems = sortSubItemsByRecentCommit(subItems)
+		}
+		for i := range subItems {
+			subItem := subItems[i]
 
 			worktreeNavItem := types.NavigableItem{
 				Type:         "worktree",
@@ -70,3 +90,55 @@ func (m *Model) rebuildNavigableItems() {
 
 	m.CachedNavItems = items
 }
+
+// syncItems marks the navigable items for rebuilding after a refresh and replaces
+// the selected item and the repository of the worktree list with their new
+// snapshots, so the open page shows the new status.
+func (m Model) syncItems() Model {
+	m.NavItemsNeedSync = true
+	if m.SelectedNavItem == nil && m.WorktreeRepo == nil {
+		return m
+	}
+
+	for _, item := range m.getNavigableItems() {
+		if m.SelectedNavItem != nil && item.Type == m.SelectedNavItem.Type && item.Path() == m.SelectedNavItem.Path() {
+			m.SelectedNavItem = &item
+		}
+		if m.WorktreeRepo != nil && item.Type == "repository" && item.Repository.Path == m.WorktreeRepo.Path {
+			m.WorktreeRepo = item.Repository
+		}
+	}
+	return m
+}
+
+// toggleSortMode switches the home list between configured order and most recent commit first.
+func (m Model) toggleSortMode() Model {
+	if m.SortMode == config.SortModeRecent {
+		m.SortMode = config.SortModeConfig
+	} else {
+		m.SortMode = config.SortModeRecent
+	}
+	m.NavItemsNeedSync = true
+	m.Cursor = 0
+	m.ScrollOffset = 0
+	return m
+}
+
+// sortByRecentCommit returns the repositories ordered by their most recent commit,
+// including commits checked out in worktrees, so stale repositories end up last.
+func sortByRecentCommit(repoItems []*repomanager.RepoItem) []*repomanager.RepoItem {
+	sorted := slices.Clone(repoItems)
+	slices.SortStableFunc(sorted, func(a, b *repomanager.RepoItem) int {
+		return b.LatestCommitTime().Compare(a.LatestCommitTime())
+	})
+	return sorted
+}
+
+// sortSubItemsByRecentCommit returns the worktrees ordered by their most recent commit.
+func sortSubItemsByRecentCommit(subItems []*repomanager.SubItem) []*repomanager.SubItem {
+	sorted := slices.Clone(subItems)
+	slices.SortStableFunc(sorted, func(a, b *repomanager.SubItem) int {
+		return b.LastCommit.Time.Compare(a.LastCommit.Time)
+	})
+	return sorted
+}
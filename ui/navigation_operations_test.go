@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+func TestSortByRecentCommit(t *testing.T) {
+	now := time.Now()
+	commitAt := func(age time.Duration) repomanager.CommitInfo {
+		return repomanager.CommitInfo{Hash: "abc1234", Time: now.Add(-age)}
+	}
+
+	old := &repomanager.RepoItem{Name: "old", LastCommit: commitAt(30 * 24 * time.Hour)}
+	empty := &repomanager.RepoItem{Name: "empty"}
+	recent := &repomanager.RepoItem{Name: "recent", LastCommit: commitAt(time.Hour)}
+	bare := &repomanager.RepoItem{Name: "bare", IsBare: true, LastCommit: commitAt(60 * 24 * time.Hour)}
+	bare.SubItems = []*repomanager.SubItem{{Name: "feature", LastCommit: commitAt(time.Minute)}}
+
+	items := []*repomanager.RepoItem{old, empty, recent, bare}
+	sorted := sortByRecentCommit(items)
+
+	var names []string
+	for _, item := range sorted {
+		names = append(names, item.Name)
+	}
+	want := []string{"bare", "recent", "old", "empty"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("sorted order = %v, want %v", names, want)
+		}
+	}
+	if items[0] != old {
+		t.Error("expected the input slice to be left untouched")
+	}
+}
+
+func TestScrollWindow(t *testing.T) {
+	tests := []struct {
+		name                     string
+		cursor, offset, delta, n int
+		wantCursor, wantOffset   int
+	}{
+		{name: "within window", cursor: 2, offset: 0, delta: 1, n: 20, wantCursor: 3, wantOffset: 0},
+		{name: "scrolls down", cursor: 4, offset: 0, delta: 1, n: 20, wantCursor: 5, wantOffset: 1},
+		{name: "scrolls up", cursor: 3, offset: 3, delta: -1, n: 20, wantCursor: 2, wantOffset: 2},
+		{name: "clamps at the end", cursor: 19, offset: 15, delta: 1, n: 20, wantCursor: 19, wantOffset: 15},
+		{name: "clamps at the start", cursor: 0, offset: 0, delta: -1, n: 20, wantCursor: 0, wantOffset: 0},
+		{name: "empty list", cursor: 0, offset: 0, delta: 1, n: 0, wantCursor: 0, wantOffset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, offset := scrollWindow(tt.cursor, tt.offset, tt.delta, tt.n, 5)
+			if cursor != tt.wantCursor || offset != tt.wantOffset {
+				t.Errorf("scrollWindow = (%d, %d), want (%d, %d)", cursor, offset, tt.wantCursor, tt.wantOffset)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Package branches renders the branch list of a repository or worktree.
+package branches
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/format"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the branch list.
+const headerLines = 2
+
+// nameWidth is the width of the branch name column.
+const nameWidth = 32
+
+// headMarker marks the branch checked out in the repository or worktree itself.
+const headMarker = "* "
+
+// BranchData holds the branches and the state of the branch page.
+type BranchData struct {
+	Name        string // Name of the repository or worktree
+	Branches    []repomanager.Branch
+	Cursor      int    // Index of the selected branch
+	Offset      int    // Index of the first visible branch
+	Loading     bool   // Whether the branches are being read
+	InputPrompt string // Prompt of the branch name being typed, empty when not typing
+	Input       string // Branch name typed so far
+	Status      string // Result of the last action or a confirmation question
+}
+
+// Renderer handles rendering of the branch page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new branch page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many branches fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, status or input line and help line
+	return max(height-headerLines-3, 5)
+}
+
+// Render renders the branch list with the selected branch highlighted.
+func (r *Renderer) Render(data BranchData, width, height int) string {
+	status := ""
+	if data.Loading {
+		status = "loading..."
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", data.Name+" branches", status, len(data.Branches), width) + "\n"
+
+	var lines []string
+	switch {
+	case data.InputPrompt != "":
+		lines = append(lines, r.styles.Input.Render(data.InputPrompt+": "+data.Input+"█"))
+	case data.Status != "":
+		lines = append(lines, r.styles.Error.Render(data.Status))
+	case len(data.Branches) == 0 && !data.Loading:
+		lines = append(lines, r.styles.Item.Render("No branches yet"))
+	}
+
+	end := min(data.Offset+VisibleRows(height), len(data.Branches))
+	now := time.Now()
+	for i := max(data.Offset, 0); i < end; i++ {
+		lines = append(lines, r.renderBranch(data.Branches[i], i == data.Cursor, width, now))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "Enter", Description: "confirm"},
+		{Key: "Esc", Description: "cancel"},
+	}
+	if data.InputPrompt == "" {
+		bindings = []help.KeyBinding{
+			{Key: "j/k", Description: "move"},
+			{Key: "Enter", Description: "checkout"},
+			{Key: "n", Description: "new from selected"},
+			{Key: "r", Description: "rename"},
+			{Key: "d", Description: "delete"},
+			{Key: "b", Description: "back"},
+		}
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderBranch renders a single branch line: markers, name, last commit date and
+// the state versus the upstream.
+func (r *Renderer) renderBranch(branch repomanager.Branch, selected bool, width int, now time.Time) string {
+	front := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
+	if selected {
+		front = r.styles.Selected.Render(r.theme.Indicators.Selected)
+	}
+
+	markWidth := max(lipgloss.Width(headMarker), lipgloss.Width(r.theme.Icons.Repository.Worktree))
+	mark := strings.Repeat(" ", markWidth)
+	switch {
+	case branch.Head:
+		mark = r.styles.Head.Render(fmt.Sprintf("%-*s", markWidth, headMarker))
+	case branch.Worktree != "":
+		icon := r.theme.Icons.Repository.Worktree
+		mark = r.styles.Worktree.Render(icon + strings.Repeat(" ", markWidth-lipgloss.Width(icon)))
+	}
+
+	nameStyle := r.styles.Item
+	switch {
+	case selected:
+		nameStyle = r.styles.Selected
+	case branch.Remote:
+		nameStyle = r.styles.Remote
+	}
+	name := nameStyle.Render(fmt.Sprintf("%-*s", nameWidth, truncate(branch.Name, nameWidth)))
+	date := r.styles.Date.Render(fmt.Sprintf("%-9s", format.RelativeTime(branch.LastCommit, now)))
+
+	line := front + mark + name + " " + date + " " + r.renderTracking(branch)
+	if lipgloss.Width(line) > width-2 {
+		line = lipgloss.NewStyle().MaxWidth(max(width-2, 0)).Render(line)
+	}
+	return line
+}
+
+// renderTracking renders the upstream of a local branch with its ahead and behind
+// counts, and whether deleting it would lose commits.
+func (r *Renderer) renderTracking(branch repomanager.Branch) string {
+	if branch.Remote {
+		return ""
+	}
+
+	var parts []string
+	switch {
+	case branch.UpstreamGone:
+		parts = append(parts, r.styles.Muted.Render("→ "+branch.Upstream+" (gone)"))
+	case branch.Upstream == "":
+		parts = append(parts, r.styles.Muted.Render("(no upstream)"))
+	default:
+		parts = append(parts, r.styles.Muted.Render("→ "+branch.Upstream))
+		if branch.Ahead > 0 {
+			parts = append(parts, r.styles.Ahead.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Unpushed, branch.Ahead)))
+		}
+		if branch.Behind > 0 {
+			parts = append(parts, r.styles.Behind.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Behind, branch.Behind)))
+		}
+	}
+	if branch.Merged && !branch.Head {
+		parts = append(parts, r.styles.Muted.Render("merged"))
+	}
+	return strings.Join(parts, " ")
+}
+
+// truncate shortens text to width runes, ending in an ellipsis when cut.
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
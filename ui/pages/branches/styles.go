@@ -0,0 +1,19 @@
+package branches
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the branch page
+type StyleConfig struct {
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Remote   lipgloss.Style
+	Head     lipgloss.Style
+	Worktree lipgloss.Style
+	Date     lipgloss.Style
+	Ahead    lipgloss.Style
+	Behind   lipgloss.Style
+	Muted    lipgloss.Style
+	Input    lipgloss.Style
+	Error    lipgloss.Style
+	Help     lipgloss.Style
+}
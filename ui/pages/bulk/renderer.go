@@ -0,0 +1,122 @@
+// Package bulk renders the per-repository results of a bulk operation.
+package bulk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the results.
+const headerLines = 2
+
+// pendingIndicator marks a repository whose operation has not finished yet.
+const pendingIndicator = "… "
+
+// Entry is the outcome of the operation on a single repository or worktree.
+type Entry struct {
+	Name  string
+	Done  bool
+	Error string // Failure reason, empty when the operation succeeded
+}
+
+// BulkData holds the results and state of the bulk operation.
+type BulkData struct {
+	Action  string // Description of the operation, e.g. "fetch"
+	Entries []Entry
+	Running bool
+	Offset  int // Index of the first visible entry
+}
+
+// Renderer handles rendering of the bulk operation page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new bulk operation page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many entries fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, summary line and help line
+	return max(height-headerLines-3, 5)
+}
+
+// Render renders the entries from the offset on, followed by a summary.
+func (r *Renderer) Render(data BulkData, width, height int) string {
+	done, failed := 0, 0
+	for _, entry := range data.Entries {
+		if entry.Done {
+			done++
+		}
+		if entry.Error != "" {
+			failed++
+		}
+	}
+
+	state := "finished"
+	if data.Running {
+		state = "running..."
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", "bulk "+data.Action, state, len(data.Entries), width) + "\n"
+
+	offset := min(max(data.Offset, 0), max(len(data.Entries)-1, 0))
+	end := min(offset+VisibleRows(height), len(data.Entries))
+	lineWidth := max(width-2, 10)
+
+	var lines []string
+	for _, entry := range data.Entries[offset:end] {
+		lines = append(lines, r.renderEntry(entry, lineWidth))
+	}
+
+	summary := fmt.Sprintf("%d succeeded, %d failed", done-failed, failed)
+	if data.Running {
+		summary = fmt.Sprintf("%d of %d done, %s", done, len(data.Entries), summary)
+	}
+	if failed > 0 {
+		lines = append(lines, r.styles.Failure.Render(summary))
+	} else {
+		lines = append(lines, r.styles.Success.Render(summary))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	var bindings []help.KeyBinding
+	if data.Running {
+		bindings = append(bindings, help.KeyBinding{Key: "x", Description: "cancel"})
+	}
+	bindings = append(bindings, help.KeyBinding{Key: "b", Description: "back"})
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderEntry renders the marker and name of an entry, followed by the first line
+// of the failure reason.
+func (r *Renderer) renderEntry(entry Entry, width int) string {
+	reason, _, _ := strings.Cut(entry.Error, "\n")
+
+	var line string
+	switch {
+	case !entry.Done:
+		line = r.styles.Pending.Render(pendingIndicator) + r.styles.Item.Render(entry.Name)
+	case entry.Error != "":
+		line = r.styles.Failure.Render(r.theme.Indicators.Error) + r.styles.Item.Render(entry.Name) + " " + r.styles.Failure.Render(reason)
+	default:
+		line = r.styles.Success.Render(r.theme.Indicators.Clean) + r.styles.Item.Render(entry.Name)
+	}
+	if lipgloss.Width(line) > width {
+		line = lipgloss.NewStyle().MaxWidth(width).Render(line)
+	}
+	return line
+}
@@ -0,0 +1,12 @@
+package bulk
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the bulk operation page
+type StyleConfig struct {
+	Item    lipgloss.Style
+	Success lipgloss.Style
+	Failure lipgloss.Style
+	Pending lipgloss.Style
+	Help    lipgloss.Style
+}
@@ -0,0 +1,202 @@
+// Package cleanup renders the report of branches that can be deleted across repositories.
+package cleanup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/format"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the branch list.
+const headerLines = 2
+
+// Widths of the repository and branch name columns.
+const (
+	repoWidth   = 20
+	branchWidth = 32
+)
+
+// CleanupData holds the report and the state of the cleanup page.
+type CleanupData struct {
+	Branches  []repomanager.CleanupBranch
+	Checked   []bool // Whether each branch is checked for deletion
+	Cursor    int    // Index of the selected branch
+	Offset    int    // Index of the first visible branch
+	Loading   bool   // Whether the report is being read
+	StaleDays int    // Days without commits after which a branch is listed
+	Confirm   bool   // Whether the confirmation screen is shown
+	Status    string // Problems reading the report or a hint
+}
+
+// Renderer handles rendering of the branch cleanup page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new branch cleanup page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many branches fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, status line and help line
+	return max(height-headerLines-3, 5)
+}
+
+// Render renders the report, or the confirmation screen once deleting was requested.
+func (r *Renderer) Render(data CleanupData, width, height int) string {
+	if data.Confirm {
+		return r.renderConfirm(data, width, height)
+	}
+
+	checked := 0
+	for _, c := range data.Checked {
+		if c {
+			checked++
+		}
+	}
+	status := fmt.Sprintf("%d checked", checked)
+	if data.Loading {
+		status = "loading..."
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", "branch cleanup", status, len(data.Branches), width) + "\n"
+
+	var lines []string
+	switch {
+	case data.Status != "":
+		lines = append(lines, r.styles.Warning.Render(data.Status))
+	case len(data.Branches) == 0 && !data.Loading:
+		lines = append(lines, r.styles.Item.Render(fmt.Sprintf("No merged or gone branches and none without commits for %d days", data.StaleDays)))
+	default:
+		lines = append(lines, r.styles.Reason.Render(fmt.Sprintf("Merged into the default branch, upstream gone, or no commits for %d days", data.StaleDays)))
+	}
+
+	end := min(data.Offset+VisibleRows(height), len(data.Branches))
+	now := time.Now()
+	for i := max(data.Offset, 0); i < end; i++ {
+		lines = append(lines, r.renderBranch(data.Branches[i], i < len(data.Checked) && data.Checked[i], i == data.Cursor, width, now))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "j/k", Description: "move"},
+		{Key: "space", Description: "check"},
+		{Key: "a/n", Description: "all/none"},
+		{Key: "d", Description: "delete checked"},
+		{Key: "r", Description: "reload"},
+		{Key: "b", Description: "back"},
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderConfirm renders the branches that will be deleted and a warning about the
+// ones whose commits are not on the default branch.
+func (r *Renderer) renderConfirm(data CleanupData, width, height int) string {
+	var selected []repomanager.CleanupBranch
+	unmerged := 0
+	for i, branch := range data.Branches {
+		if i < len(data.Checked) && data.Checked[i] {
+			selected = append(selected, branch)
+			if !branch.Merged {
+				unmerged++
+			}
+		}
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", "branch cleanup", "confirm", len(selected), width) + "\n"
+
+	question := fmt.Sprintf("Delete %d branches? Press y to delete, any other key to go back", len(selected))
+	lines := []string{r.styles.Item.Render(question)}
+	if unmerged > 0 {
+		lines = append(lines, r.styles.Warning.Render(fmt.Sprintf("%d of them are not merged into the default branch, their commits will be lost", unmerged)))
+	}
+
+	// Keep a line for the number of branches that do not fit
+	rows := max(VisibleRows(height)+1-len(lines), 2)
+	shown := selected
+	if len(shown) > rows {
+		shown = shown[:rows-1]
+	}
+	now := time.Now()
+	for _, branch := range shown {
+		lines = append(lines, r.renderBranch(branch, true, false, width, now))
+	}
+	if hidden := len(selected) - len(shown); hidden > 0 {
+		lines = append(lines, r.styles.Reason.Render(fmt.Sprintf("... and %d more", hidden)))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "y", Description: "delete"},
+		{Key: "any other key", Description: "back"},
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderBranch renders a single branch: check marker, repository, name and the
+// reasons it is listed.
+func (r *Renderer) renderBranch(branch repomanager.CleanupBranch, checked, selected bool, width int, now time.Time) string {
+	front := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
+	if selected {
+		front = r.styles.Selected.Render(r.theme.Indicators.Selected)
+	}
+	mark := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Marked))
+	if checked {
+		mark = r.styles.Marked.Render(r.theme.Indicators.Marked)
+	}
+
+	nameStyle := r.styles.Item
+	if selected {
+		nameStyle = r.styles.Selected
+	}
+	repo := r.styles.Repo.Render(fmt.Sprintf("%-*s", repoWidth, truncate(branch.RepoName, repoWidth)))
+	name := nameStyle.Render(fmt.Sprintf("%-*s", branchWidth, truncate(branch.Branch.Name, branchWidth)))
+
+	var reasons []string
+	if branch.Merged {
+		reasons = append(reasons, "merged into "+branch.DefaultBranch)
+	}
+	if branch.Gone {
+		reasons = append(reasons, "upstream gone")
+	}
+	if branch.Stale {
+		reasons = append(reasons, "last commit "+format.RelativeTime(branch.Branch.LastCommit, now))
+	}
+	reasonStyle := r.styles.Reason
+	if !branch.Merged {
+		reasonStyle = r.styles.Warning
+	}
+
+	line := front + mark + repo + " " + name + " " + reasonStyle.Render(strings.Join(reasons, ", "))
+	if lipgloss.Width(line) > width-2 {
+		line = lipgloss.NewStyle().MaxWidth(max(width-2, 0)).Render(line)
+	}
+	return line
+}
+
+// truncate shortens text to width runes, ending in an ellipsis when cut.
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,14 @@
+package cleanup
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the branch cleanup page
+type StyleConfig struct {
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Marked   lipgloss.Style
+	Repo     lipgloss.Style
+	Reason   lipgloss.Style
+	Warning  lipgloss.Style
+	Help     lipgloss.Style
+}
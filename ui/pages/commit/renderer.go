@@ -0,0 +1,163 @@
+// Package commit renders the commit composer of a repository or worktree.
+package commit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the composer.
+const headerLines = 2
+
+// MessageRows is the number of message lines shown at once; longer messages
+// scroll so the line being typed stays visible.
+const MessageRows = 8
+
+// StagedRows is the number of staged files listed before the rest is summarized.
+const StagedRows = 8
+
+// cursor marks the end of the message, where typed text is inserted.
+const cursor = "█"
+
+// CommitData holds the message being composed and the files it will commit.
+type CommitData struct {
+	Name    string // Name of the repository or worktree
+	Branch  string // Checked out branch, empty when HEAD is detached
+	Message string
+	Amend   bool
+	SignOff bool
+	Files   []repomanager.ChangedFile // Changed files, only the staged ones are committed
+	Running bool                      // Whether git commit is running
+	Status  string                    // Error of the last attempt or a validation hint
+}
+
+// Renderer handles rendering of the commit page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new commit page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// Render renders the message editor, the options and the staged-files summary.
+func (r *Renderer) Render(data CommitData, width, height int) string {
+	staged, left := countFiles(data.Files)
+
+	title := data.Name + " commit"
+	if data.Branch != "" {
+		title += " on " + data.Branch
+	}
+	status := "new commit"
+	if data.Running {
+		status = "committing..."
+	} else if data.Amend {
+		status = "amend"
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", title, status, staged, width) + "\n"
+
+	lines := []string{r.styles.Title.Render("Message")}
+	lines = append(lines, r.renderEditor(data.Message, width))
+	lines = append(lines,
+		"",
+		r.renderOption("Amend last commit", data.Amend),
+		r.renderOption("Sign off", data.SignOff),
+	)
+
+	if data.Status != "" {
+		lines = append(lines, "", r.styles.Error.Render(data.Status))
+	}
+
+	lines = append(lines, "", r.styles.Title.Render(fmt.Sprintf("Staged Files (%d)", staged)))
+	lines = append(lines, r.renderStaged(data.Files, staged, data.Amend)...)
+	if left > 0 {
+		lines = append(lines, r.styles.Value.Render(fmt.Sprintf("%d other changes (unstaged, untracked or conflicted) are left out", left)))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "ctrl+s", Description: "commit"},
+		{Key: "Enter", Description: "new line"},
+		{Key: "ctrl+a", Description: "amend"},
+		{Key: "ctrl+o", Description: "sign off"},
+		{Key: "Esc", Description: "cancel"},
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderEditor renders the last MessageRows lines of the message in a bordered box
+// with the cursor after the last character.
+func (r *Renderer) renderEditor(message string, width int) string {
+	messageLines := strings.Split(message+cursor, "\n")
+	if len(messageLines) > MessageRows {
+		messageLines = messageLines[len(messageLines)-MessageRows:]
+	}
+	for len(messageLines) < 3 {
+		messageLines = append(messageLines, "")
+	}
+
+	// Account for the border and padding of the editor box
+	return r.styles.Editor.Width(max(width-4, 20)).Render(strings.Join(messageLines, "\n"))
+}
+
+// renderOption renders a toggle as a checkbox.
+func (r *Renderer) renderOption(label string, enabled bool) string {
+	box := "[ ]"
+	if enabled {
+		box = "[x]"
+	}
+	return r.styles.Item.Render(box + " " + label)
+}
+
+// renderStaged lists the staged files, summarizing those beyond StagedRows.
+func (r *Renderer) renderStaged(files []repomanager.ChangedFile, staged int, amend bool) []string {
+	if staged == 0 {
+		if amend {
+			return []string{r.styles.Value.Render("Nothing staged, only the message will change")}
+		}
+		return []string{r.styles.Value.Render("Nothing staged, stage files in the details view first")}
+	}
+
+	var lines []string
+	for _, file := range files {
+		if file.Group != repomanager.FileStaged {
+			continue
+		}
+		if len(lines) == StagedRows {
+			lines = append(lines, r.styles.Value.Render(fmt.Sprintf("... and %d more", staged-StagedRows)))
+			break
+		}
+
+		name := file.Path
+		if file.OrigPath != "" {
+			name = file.OrigPath + " → " + file.Path
+		}
+		lines = append(lines, r.styles.Staged.Render(string(file.Code))+" "+r.styles.Item.Render(name))
+	}
+	return lines
+}
+
+// countFiles returns the number of staged files and the number of other changed files.
+func countFiles(files []repomanager.ChangedFile) (staged, left int) {
+	for _, file := range files {
+		if file.Group == repomanager.FileStaged {
+			staged++
+		} else {
+			left++
+		}
+	}
+	return staged, left
+}
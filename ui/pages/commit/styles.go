@@ -0,0 +1,14 @@
+package commit
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the commit page
+type StyleConfig struct {
+	Item   lipgloss.Style
+	Title  lipgloss.Style
+	Value  lipgloss.Style
+	Editor lipgloss.Style
+	Staged lipgloss.Style
+	Error  lipgloss.Style
+	Help   lipgloss.Style
+}
@@ -0,0 +1,196 @@
+// Package commitlog renders the commit history of a repository or worktree.
+package commitlog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/format"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the scrollable content.
+const headerLines = 2
+
+// LogData holds the loaded history and the scroll state of the log.
+type LogData struct {
+	Name    string // Name of the repository or worktree
+	Entries []repomanager.LogEntry
+	Cursor  int  // Index of the selected entry
+	Offset  int  // Index of the first visible entry
+	HasMore bool // Whether older commits can still be loaded
+	Loading bool
+	Status  string // Error of the last read, shown above the log
+}
+
+// Renderer handles rendering of the commit log page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new commit log page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many log or commit lines fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line and help line
+	return max(height-headerLines-2, 5)
+}
+
+// RenderLog renders the commit graph with the selected commit highlighted.
+func (r *Renderer) RenderLog(data LogData, width, height int) string {
+	commits := 0
+	for _, entry := range data.Entries {
+		if entry.IsCommit() {
+			commits++
+		}
+	}
+
+	status := ""
+	if data.Loading {
+		status = "loading..."
+	} else if data.HasMore {
+		status = "more below"
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", data.Name+" log", status, commits, width) + "\n"
+
+	var lines []string
+	switch {
+	case data.Status != "":
+		lines = append(lines, r.styles.Deleted.Render(data.Status))
+	case len(data.Entries) == 0 && !data.Loading:
+		lines = append(lines, r.styles.Item.Render("No commits yet"))
+	}
+
+	end := min(data.Offset+VisibleRows(height), len(data.Entries))
+	now := time.Now()
+	for i := data.Offset; i < end; i++ {
+		lines = append(lines, r.renderEntry(data.Entries[i], i == data.Cursor, width, now))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "j/k", Description: "move"},
+		{Key: "PgUp/PgDn", Description: "page"},
+		{Key: "g/G", Description: "top/bottom"},
+		{Key: "Enter", Description: "show commit"},
+		{Key: "b", Description: "back"},
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderEntry renders a single log line, truncating the subject to the width.
+func (r *Renderer) renderEntry(entry repomanager.LogEntry, selected bool, width int, now time.Time) string {
+	frontWidth := lipgloss.Width(r.theme.Indicators.Selected)
+	front := strings.Repeat(" ", frontWidth)
+	if selected {
+		front = r.styles.Selected.Render(r.theme.Indicators.Selected)
+	}
+
+	markWidth := lipgloss.Width(r.theme.Indicators.Unpushed)
+	mark := strings.Repeat(" ", markWidth)
+	if entry.Unpushed {
+		mark = r.styles.Unpushed.Render(r.theme.Indicators.Unpushed)
+	}
+
+	if !entry.IsCommit() {
+		return front + mark + " " + r.styles.Graph.Render(entry.Graph)
+	}
+
+	date := fmt.Sprintf("%-9s", format.RelativeTime(entry.Time, now))
+	author := fmt.Sprintf("%-16s", truncate(entry.Author, 16))
+	prefix := front + mark + " " + r.styles.Graph.Render(entry.Graph) + r.styles.Hash.Render(entry.ShortHash) + " " +
+		r.styles.Date.Render(date) + " " + r.styles.Author.Render(author) + " "
+
+	subjectStyle := r.styles.Item
+	if selected {
+		subjectStyle = r.styles.Selected
+	}
+	available := max(width-2-lipgloss.Width(prefix), 0)
+	return prefix + subjectStyle.Render(truncate(entry.Subject, available))
+}
+
+// RenderCommit renders the full message and changed-file statistics of a commit.
+func (r *Renderer) RenderCommit(name string, detail repomanager.CommitDetail, offset, width, height int) string {
+	content := r.header.RenderWithCountAndSpacing("git-dash", name+" commit", len(detail.Files), width) + "\n"
+
+	lines := r.commitLines(detail)
+	offset = min(offset, MaxCommitOffset(detail, height))
+	end := min(offset+VisibleRows(height), len(lines))
+	if offset < end {
+		content += strings.Join(lines[offset:end], "\n")
+	}
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "j/k", Description: "scroll"},
+		{Key: "b", Description: "back to log"},
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// MaxCommitOffset returns the furthest the commit view can scroll at the given height.
+func MaxCommitOffset(detail repomanager.CommitDetail, height int) int {
+	// Three header fields, the message, a blank line before and after it, the totals and one line per file
+	lines := 3 + 1 + strings.Count(detail.Message, "\n") + 1 + 1 + 1 + len(detail.Files)
+	return max(lines-VisibleRows(height), 0)
+}
+
+// commitLines returns the lines of the commit view.
+func (r *Renderer) commitLines(detail repomanager.CommitDetail) []string {
+	lines := []string{
+		r.styles.Label.Render("Commit:  ") + r.styles.Hash.Render(detail.Hash),
+		r.styles.Label.Render("Author:  ") + r.styles.Author.Render(fmt.Sprintf("%s <%s>", detail.Author, detail.Email)),
+		r.styles.Label.Render("Date:    ") + r.styles.Date.Render(fmt.Sprintf("%s (%s)", detail.Time.Format("2006-01-02 15:04:05"), format.RelativeTime(detail.Time, time.Now()))),
+		"",
+	}
+	for _, line := range strings.Split(detail.Message, "\n") {
+		lines = append(lines, "    "+r.styles.Item.Render(line))
+	}
+
+	added, deleted := 0, 0
+	for _, file := range detail.Files {
+		added += file.Added
+		deleted += file.Deleted
+	}
+	lines = append(lines, "", r.styles.Label.Render(fmt.Sprintf("%d files changed, ", len(detail.Files)))+
+		r.styles.Added.Render(fmt.Sprintf("+%d", added))+" "+r.styles.Deleted.Render(fmt.Sprintf("-%d", deleted)))
+
+	for _, file := range detail.Files {
+		var stat string
+		if file.Binary {
+			stat = r.styles.Date.Render(fmt.Sprintf("%-13s", "binary"))
+		} else {
+			stat = r.styles.Added.Render(fmt.Sprintf("%6s", fmt.Sprintf("+%d", file.Added))) + " " +
+				r.styles.Deleted.Render(fmt.Sprintf("%-6s", fmt.Sprintf("-%d", file.Deleted)))
+		}
+		lines = append(lines, "  "+stat+" "+r.styles.Item.Render(file.Path))
+	}
+	return lines
+}
+
+// truncate shortens text to at most width runes, marking the cut with an ellipsis.
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,18 @@
+package commitlog
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the commit log page
+type StyleConfig struct {
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Graph    lipgloss.Style
+	Hash     lipgloss.Style
+	Date     lipgloss.Style
+	Author   lipgloss.Style
+	Unpushed lipgloss.Style
+	Added    lipgloss.Style
+	Deleted  lipgloss.Style
+	Label    lipgloss.Style
+	Help     lipgloss.Style
+}
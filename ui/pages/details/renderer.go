@@ -3,10 +3,13 @@ package details
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/jarmocluyse/git-dash/internal/repomanager"
 	"github.com/jarmocluyse/git-dash/internal/theme"
 	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/format"
 	"github.com/jarmocluyse/git-dash/ui/header"
 	"github.com/jarmocluyse/git-dash/ui/types"
 )
@@ -27,8 +30,30 @@ func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
 	}
 }
 
+// FileRows is the number of changed-file rows shown at once, group headings included.
+const FileRows = 10
+
+// FileSection holds the changed files shown below the repository details.
+type FileSection struct {
+	Files   []repomanager.ChangedFile // Sorted by group
+	Cursor  int
+	Offset  int    // First visible row
+	Focused bool   // Whether the cursor keys move through the files
+	Status  string // Error of the last read or editor run
+
+	EditorKey string // Key that opens the selected file, shown in the help line
+}
+
+// StashSection holds the stash list shown below the repository details.
+type StashSection struct {
+	Entries []repomanager.StashEntry
+	Cursor  int
+	Focused bool   // Whether the cursor keys move through the stashes
+	Status  string // Result of the last stash action or a pending confirmation
+}
+
 // Render renders the repository details view
-func (r *Renderer) Render(item types.NavigableItem, width, height int) string {
+func (r *Renderer) Render(item types.NavigableItem, files FileSection, stashes StashSection, width, height int) string {
 	var detailsContent string
 
 	switch item.Type {
@@ -37,6 +62,10 @@ func (r *Renderer) Render(item types.NavigableItem, width, height int) string {
 	case "worktree":
 		detailsContent = r.renderWorktreeDetails(item.WorktreeInfo, item.ParentRepo)
 	}
+	if item.Type == "worktree" || !item.Repository.IsBare {
+		detailsContent += "\n\n" + r.renderFiles(files)
+	}
+	detailsContent += "\n\n" + r.renderStashes(stashes, time.Now())
 
 	// Build content with git-dash title like home page, then repository details title
 	content := r.header.RenderWithCountAndSpacing("git-dash", "", 1, width)
@@ -54,6 +83,31 @@ func (r *Renderer) Render(item types.NavigableItem, width, height int) string {
 	bindings := []help.KeyBinding{
 		{Key: "b", Description: "back"},
 		{Key: "Esc", Description: "back"},
+		{Key: "l", Description: "log"},
+		{Key: "B", Description: "branches"},
+		{Key: "W", Description: "worktrees"},
+		{Key: "Tab", Description: "files/stashes"},
+	}
+	if item.Type == "worktree" || !item.Repository.IsBare {
+		bindings = append(bindings,
+			help.KeyBinding{Key: "C", Description: "commit"},
+			help.KeyBinding{Key: "P/U", Description: "push/pull"},
+		)
+	}
+	if len(files.Files) > 0 {
+		bindings = append(bindings,
+			help.KeyBinding{Key: "s/u", Description: "stage/unstage"},
+			help.KeyBinding{Key: "x", Description: "discard"},
+			help.KeyBinding{Key: "v", Description: "diff"},
+			help.KeyBinding{Key: files.EditorKey, Description: "edit file"},
+		)
+	}
+	if len(stashes.Entries) > 0 {
+		bindings = append(bindings,
+			help.KeyBinding{Key: "a", Description: "apply stash"},
+			help.KeyBinding{Key: "p", Description: "pop stash"},
+			help.KeyBinding{Key: "d", Description: "drop stash"},
+		)
 	}
 
 	// Use header count of 4 for git-dash title + details title
@@ -68,6 +122,9 @@ func (r *Renderer) renderRepositoryDetails(repo *repomanager.RepoItem) string {
 	details = append(details, r.renderField("Name", repo.Name))
 	details = append(details, r.renderField("Path", repo.Path))
 	details = append(details, r.renderField("Type", r.getRepoType(repo)))
+	if !repo.IsBare && !repo.HasError {
+		details = append(details, r.renderHeadFields(repo.Branch, repo.Upstream, repo.Detached, repo.HeadCommit)...)
+	}
 
 	// Show cached status information (only for non-bare repositories)
 	if repo.HasError {
@@ -81,7 +138,10 @@ func (r *Renderer) renderRepositoryDetails(repo *repomanager.RepoItem) string {
 			statusParts = append(statusParts, "Untracked files")
 		}
 		if repo.HasUnpushed {
-			statusParts = append(statusParts, "Unpushed commits")
+			statusParts = append(statusParts, fmt.Sprintf("%d unpushed commits", repo.UnpushedCount))
+		}
+		if repo.HasBehind {
+			statusParts = append(statusParts, fmt.Sprintf("%d commits behind upstream", repo.BehindCount))
 		}
 
 		if len(statusParts) > 0 {
@@ -89,6 +149,21 @@ func (r *Renderer) renderRepositoryDetails(repo *repomanager.RepoItem) string {
 		} else {
 			details = append(details, r.renderField("Status", "Clean"))
 		}
+
+		if repo.Operation.InProgress() {
+			details = append(details, r.renderField("In Progress", r.formatOperation(repo.Operation, repo.ConflictedCount)))
+		}
+		if repo.HasUncommitted {
+			details = append(details, r.renderField("Changes", r.formatChangeCounts(repo.StagedCount, repo.UnstagedCount, repo.ConflictedCount, repo.RenamedCount)))
+		}
+	}
+
+	if !repo.HasError {
+		details = append(details, r.renderCommitFields(repo.LastCommit)...)
+		details = append(details, r.renderField("Last Fetch", format.RelativeTime(repo.LastFetch, time.Now())))
+	}
+	if repo.FetchError != "" {
+		details = append(details, r.renderField("Fetch Error", repo.FetchError))
 	}
 
 	if repo.IsWorktree {
@@ -109,8 +184,21 @@ func (r *Renderer) renderWorktreeDetails(worktree *repomanager.SubItem, parentRe
 	// Basic info
 	details = append(details, r.renderField("Name", worktree.Name))
 	details = append(details, r.renderField("Path", worktree.Path))
-	details = append(details, r.renderField("Branch", worktree.Branch))
+	if worktree.HasError {
+		details = append(details, r.renderField("Branch", worktree.Branch))
+	} else {
+		details = append(details, r.renderHeadFields(worktree.Branch, worktree.Upstream, worktree.Detached, worktree.HeadCommit)...)
+	}
 	details = append(details, r.renderField("Parent Repository", parentRepo.Name))
+	if worktree.Locked {
+		details = append(details, r.renderField("Locked", withReason("yes", worktree.LockReason)))
+	}
+	if worktree.Prunable {
+		details = append(details, r.renderField("Prunable", withReason("yes", worktree.PruneReason)))
+	}
+	if !worktree.HasError {
+		details = append(details, r.renderCommitFields(worktree.LastCommit)...)
+	}
 
 	// Show cached status information
 	if worktree.HasError {
@@ -124,7 +212,10 @@ func (r *Renderer) renderWorktreeDetails(worktree *repomanager.SubItem, parentRe
 			statusParts = append(statusParts, "Untracked files")
 		}
 		if worktree.HasUnpushed {
-			statusParts = append(statusParts, "Unpushed commits")
+			statusParts = append(statusParts, fmt.Sprintf("%d unpushed commits", worktree.UnpushedCount))
+		}
+		if worktree.HasBehind {
+			statusParts = append(statusParts, fmt.Sprintf("%d commits behind upstream", worktree.BehindCount))
 		}
 
 		if len(statusParts) > 0 {
@@ -132,11 +223,190 @@ func (r *Renderer) renderWorktreeDetails(worktree *repomanager.SubItem, parentRe
 		} else {
 			details = append(details, r.renderField("Status", "Clean"))
 		}
+
+		if worktree.Operation.InProgress() {
+			details = append(details, r.renderField("In Progress", r.formatOperation(worktree.Operation, worktree.ConflictedCount)))
+		}
+		if worktree.HasUncommitted {
+			details = append(details, r.renderField("Changes", r.formatChangeCounts(worktree.StagedCount, worktree.UnstagedCount, worktree.ConflictedCount, worktree.RenamedCount)))
+		}
 	}
 
 	return strings.Join(details, "\n")
 }
 
+// withReason appends a reason in parentheses to value when one is given.
+func withReason(value, reason string) string {
+	if reason == "" {
+		return value
+	}
+	return value + " (" + reason + ")"
+}
+
+// FileRow returns the row of the file at index, counting the group headings above it.
+func FileRow(files []repomanager.ChangedFile, index int) int {
+	row := 0
+	for i := 0; i <= index && i < len(files); i++ {
+		if i == 0 || files[i].Group != files[i-1].Group {
+			row++
+		}
+		row++
+	}
+	return row - 1
+}
+
+// FileRowCount returns the number of rows of the changed-files list.
+func FileRowCount(files []repomanager.ChangedFile) int {
+	if len(files) == 0 {
+		return 0
+	}
+	return FileRow(files, len(files)-1) + 1
+}
+
+// renderFiles renders the changed files grouped by status, scrolled to the visible rows.
+func (r *Renderer) renderFiles(files FileSection) string {
+	lines := []string{r.styles.Title.Render(fmt.Sprintf("Changed Files (%d)", len(files.Files)))}
+
+	if len(files.Files) == 0 && files.Status == "" {
+		lines = append(lines, r.styles.Value.Render("No changes"))
+	}
+
+	var rows []string
+	padding := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
+	for i, file := range files.Files {
+		style := r.groupStyle(file.Group)
+		if i == 0 || file.Group != files.Files[i-1].Group {
+			rows = append(rows, style.Render(fmt.Sprintf("%s (%d)", file.Group, countGroup(files.Files, file.Group))))
+		}
+
+		name := file.Path
+		if file.OrigPath != "" {
+			name = file.OrigPath + " → " + file.Path
+		}
+		if files.Focused && i == files.Cursor {
+			rows = append(rows, r.styles.SelectedItem.Render(r.theme.Indicators.Selected)+style.Render(string(file.Code))+" "+r.styles.SelectedItem.Render(name))
+		} else {
+			rows = append(rows, padding+style.Render(string(file.Code))+" "+r.styles.Item.Render(name))
+		}
+	}
+
+	start := min(files.Offset, len(rows))
+	end := min(start+FileRows, len(rows))
+	lines = append(lines, rows[start:end]...)
+	if start > 0 || end < len(rows) {
+		lines = append(lines, r.styles.Value.Render(fmt.Sprintf("rows %d-%d of %d", start+1, end, len(rows))))
+	}
+
+	if files.Status != "" {
+		lines = append(lines, r.styles.Value.Render(files.Status))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// groupStyle returns the style of a changed-file group heading and status letters.
+func (r *Renderer) groupStyle(group repomanager.FileGroup) lipgloss.Style {
+	switch group {
+	case repomanager.FileConflicted:
+		return r.styles.Conflicted
+	case repomanager.FileStaged:
+		return r.styles.Staged
+	case repomanager.FileUnstaged:
+		return r.styles.Unstaged
+	}
+	return r.styles.Untracked
+}
+
+// countGroup returns the number of files in a group.
+func countGroup(files []repomanager.ChangedFile, group repomanager.FileGroup) int {
+	count := 0
+	for _, file := range files {
+		if file.Group == group {
+			count++
+		}
+	}
+	return count
+}
+
+// renderStashes renders the stash list with the selected entry highlighted.
+func (r *Renderer) renderStashes(stashes StashSection, now time.Time) string {
+	lines := []string{r.styles.Title.Render(fmt.Sprintf("Stashes (%d)", len(stashes.Entries)))}
+
+	if len(stashes.Entries) == 0 {
+		lines = append(lines, r.styles.Value.Render("No stashes"))
+	}
+	for i, entry := range stashes.Entries {
+		line := fmt.Sprintf("%-10s %-20s %-9s %s", entry.Ref, entry.Branch, format.RelativeTime(entry.Time, now), entry.Message)
+		if stashes.Focused && i == stashes.Cursor {
+			lines = append(lines, r.styles.SelectedItem.Render(r.theme.Indicators.Selected+line))
+		} else {
+			lines = append(lines, r.styles.Item.Render(strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))+line))
+		}
+	}
+
+	if stashes.Status != "" {
+		lines = append(lines, "", r.styles.Value.Render(stashes.Status))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderHeadFields renders the branch, upstream and HEAD commit of a working tree.
+func (r *Renderer) renderHeadFields(branch, upstream string, detached bool, headCommit string) []string {
+	if detached {
+		return []string{
+			r.renderField("Branch", "(detached HEAD)"),
+			r.renderField("HEAD", headCommit),
+		}
+	}
+
+	if upstream == "" {
+		upstream = "none configured"
+	}
+	if headCommit == "" {
+		headCommit = "no commits yet"
+	}
+	return []string{
+		r.renderField("Branch", branch),
+		r.renderField("Upstream", upstream),
+		r.renderField("HEAD", headCommit),
+	}
+}
+
+// renderCommitFields renders the hash, subject, author and age of the HEAD commit.
+func (r *Renderer) renderCommitFields(commit repomanager.CommitInfo) []string {
+	if commit.IsZero() {
+		return []string{r.renderField("Last Commit", "none")}
+	}
+
+	return []string{
+		r.renderField("Last Commit", fmt.Sprintf("%s %s", commit.Hash, commit.Subject)),
+		r.renderField("Author", commit.Author),
+		r.renderField("Committed", format.RelativeTime(commit.Time, time.Now())),
+	}
+}
+
+// formatOperation describes an in-progress operation and its unresolved conflicts.
+func (r *Renderer) formatOperation(operation repomanager.Operation, conflicted int) string {
+	if conflicted == 0 {
+		return operation.String()
+	}
+	return fmt.Sprintf("%s, %d conflicted files", operation, conflicted)
+}
+
+// formatChangeCounts describes how uncommitted changes are split between index and working tree.
+func (r *Renderer) formatChangeCounts(staged, unstaged, conflicted, renamed int) string {
+	parts := []string{
+		fmt.Sprintf("%d staged", staged),
+		fmt.Sprintf("%d unstaged", unstaged),
+	}
+	if conflicted > 0 {
+		parts = append(parts, fmt.Sprintf("%d conflicted", conflicted))
+	}
+	if renamed > 0 {
+		parts = append(parts, fmt.Sprintf("%d renamed", renamed))
+	}
+	return strings.Join(parts, ", ")
+}
+
 // renderField renders a labeled field with consistent formatting.
 func (r *Renderer) renderField(label, value string) string {
 	labelStyled := r.styles.Label.Render(label + ":")
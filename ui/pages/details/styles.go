@@ -11,4 +11,8 @@ type StyleConfig struct {
 	Help         lipgloss.Style
 	Border       lipgloss.Style
 	Title        lipgloss.Style
+	Conflicted   lipgloss.Style
+	Staged       lipgloss.Style
+	Unstaged     lipgloss.Style
+	Untracked    lipgloss.Style
 }
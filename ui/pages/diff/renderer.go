@@ -0,0 +1,158 @@
+// Package diff renders the staged or unstaged changes of a file or working tree.
+package diff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the scrollable diff.
+const headerLines = 2
+
+// tabWidth is the number of spaces a tab expands to.
+const tabWidth = 4
+
+// DiffData holds the loaded diff and the scroll state of the page.
+type DiffData struct {
+	Name    string // Name of the repository or worktree
+	File    string // Compared file, empty for the whole working tree
+	Staged  bool
+	Diff    repomanager.Diff
+	Offset  int  // First visible diff line
+	Column  int  // First visible column when lines are not wrapped
+	Wrap    bool // Whether long lines wrap instead of scrolling horizontally
+	Loading bool
+	Status  string // Error of the last read or result of the last hunk action
+}
+
+// Renderer handles rendering of the diff page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new diff page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many diff lines fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line and help line
+	return max(height-headerLines-2, 5)
+}
+
+// CurrentHunk returns the index of the hunk the line at offset belongs to, or -1
+// when the offset is above the first hunk.
+func CurrentHunk(diff repomanager.Diff, offset int) int {
+	current := -1
+	for i, line := range diff.Hunks {
+		if line <= offset {
+			current = i
+		}
+	}
+	return current
+}
+
+// Render renders the visible part of the diff.
+func (r *Renderer) Render(data DiffData, width, height int) string {
+	title := data.Name + " diff"
+	if data.File != "" {
+		title += " " + data.File
+	}
+
+	side := "unstaged"
+	if data.Staged {
+		side = "staged"
+	}
+	status := side
+	if data.Loading {
+		status = "loading..."
+	} else if len(data.Diff.Hunks) > 0 {
+		status = fmt.Sprintf("%s  hunk %d/%d", side, max(CurrentHunk(data.Diff, data.Offset)+1, 1), len(data.Diff.Hunks))
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", title, status, len(data.Diff.Hunks), width) + "\n"
+
+	var lines []string
+	if data.Status != "" {
+		lines = append(lines, r.styles.Hunk.Render(data.Status))
+	}
+	if len(data.Diff.Lines) == 0 && !data.Loading {
+		lines = append(lines, r.styles.Context.Render(fmt.Sprintf("No %s changes", side)))
+	}
+
+	rows := VisibleRows(height) - len(lines)
+	lineWidth := max(width-2, 10)
+	for i := data.Offset; i < len(data.Diff.Lines) && rows > 0; i++ {
+		line := data.Diff.Lines[i]
+		style := r.lineStyle(line.Kind)
+		text := []rune(strings.ReplaceAll(line.Text, "\t", strings.Repeat(" ", tabWidth)))
+
+		if !data.Wrap {
+			text = text[min(data.Column, len(text)):]
+			lines = append(lines, style.Render(string(text[:min(lineWidth, len(text))])))
+			rows--
+			continue
+		}
+
+		for start := 0; rows > 0; start += lineWidth {
+			lines = append(lines, style.Render(string(text[start:min(start+lineWidth, len(text))])))
+			rows--
+			if start+lineWidth >= len(text) {
+				break
+			}
+		}
+	}
+	content += strings.Join(lines, "\n")
+
+	wrapDescription := "wrap"
+	if data.Wrap {
+		wrapDescription = "no wrap"
+	}
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "j/k", Description: "scroll"},
+		{Key: "n/N", Description: "next/prev hunk"},
+		{Key: "Tab", Description: "staged/unstaged"},
+	}
+	if data.Staged {
+		bindings = append(bindings, help.KeyBinding{Key: "u", Description: "unstage hunk"})
+	} else {
+		bindings = append(bindings,
+			help.KeyBinding{Key: "s", Description: "stage hunk"},
+			help.KeyBinding{Key: "x", Description: "discard hunk"},
+		)
+	}
+	bindings = append(bindings, help.KeyBinding{Key: "w", Description: wrapDescription})
+	if !data.Wrap {
+		bindings = append(bindings, help.KeyBinding{Key: "←/→", Description: "scroll sideways"})
+	}
+	bindings = append(bindings, help.KeyBinding{Key: "b", Description: "back"})
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// lineStyle returns the style of a diff line kind.
+func (r *Renderer) lineStyle(kind repomanager.DiffLineKind) lipgloss.Style {
+	switch kind {
+	case repomanager.DiffAdded:
+		return r.styles.Added
+	case repomanager.DiffRemoved:
+		return r.styles.Removed
+	case repomanager.DiffHunk:
+		return r.styles.Hunk
+	case repomanager.DiffHeader:
+		return r.styles.Header
+	}
+	return r.styles.Context
+}
@@ -0,0 +1,13 @@
+package diff
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the diff page
+type StyleConfig struct {
+	Context lipgloss.Style
+	Added   lipgloss.Style
+	Removed lipgloss.Style
+	Hunk    lipgloss.Style
+	Header  lipgloss.Style
+	Help    lipgloss.Style
+}
@@ -13,6 +13,12 @@ import (
 	"github.com/jarmocluyse/git-dash/ui/types"
 )
 
+// Selection describes the multi-select state of the list.
+type Selection struct {
+	Active bool            // Whether the list is in select mode
+	Marked map[string]bool // Paths of the marked repositories and worktrees
+}
+
 // Renderer handles rendering of the home page (repository list)
 type Renderer struct {
 	styles StyleConfig
@@ -59,9 +65,11 @@ func (r *Renderer) RenderRepositoryList(repositories []*repomanager.RepoItem, su
 	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, 4) // Increased header count
 }
 
-// RenderNavigableList renders the navigable repository list (with worktrees as separate items)
-func (r *Renderer) RenderNavigableList(items []types.NavigableItem, summaryData repomanager.SummaryData, cursor int, width, height int, actions []config.Action, configTitle string) string {
-	content := r.header.RenderWithCountAndSpacing("git-dash", configTitle, len(items), width)
+// RenderNavigableList renders the navigable repository list (with worktrees as separate items),
+// showing the refresh status on the right side of the header. In select mode marked items
+// are flagged and the help lists the bulk operations instead.
+func (r *Renderer) RenderNavigableList(items []types.NavigableItem, summaryData repomanager.SummaryData, cursor int, width, height int, actions []config.Action, configTitle, refreshStatus string, selection Selection) string {
+	content := r.header.RenderWithStatusAndSpacing("git-dash", configTitle, refreshStatus, len(items), width)
 
 	// Add summary header
 	content += r.renderSummaryHeader(summaryData, width)
@@ -69,12 +77,16 @@ func (r *Renderer) RenderNavigableList(items []types.NavigableItem, summaryData
 	if len(items) == 0 {
 		content += r.renderEmptyState()
 	} else {
-		content += r.renderNavigableItemList(items, cursor, width)
+		content += r.renderNavigableItemList(items, cursor, width, selection)
 	}
 
 	// Use help component to render with bottom-aligned help
 	helpBuilder := help.NewBuilder(r.styles.Help)
 
+	if selection.Active {
+		return helpBuilder.RenderWithBottomHelpAndHeader(content, r.selectionBindings(actions), width, height, 4)
+	}
+
 	// Build help bindings from actions
 	var bindings []help.KeyBinding
 	for _, action := range actions {
@@ -84,24 +96,48 @@ func (r *Renderer) RenderNavigableList(items []types.NavigableItem, summaryData
 		})
 	}
 	bindings = append(bindings, help.KeyBinding{Key: "e", Description: "open in file manager"})
+	bindings = append(bindings, help.KeyBinding{Key: "v", Description: "select"})
+	bindings = append(bindings, help.KeyBinding{Key: "X", Description: "cleanup"})
+	bindings = append(bindings, help.KeyBinding{Key: "S", Description: "scan"})
+	bindings = append(bindings, help.KeyBinding{Key: "p", Description: "pause refresh"})
+	bindings = append(bindings, help.KeyBinding{Key: "o", Description: "sort"})
+	bindings = append(bindings, help.KeyBinding{Key: "C", Description: "commit"})
+	bindings = append(bindings, help.KeyBinding{Key: "B", Description: "branches"})
+	bindings = append(bindings, help.KeyBinding{Key: "w/W", Description: "new/manage worktrees"})
+	bindings = append(bindings, help.KeyBinding{Key: "P/U", Description: "push/pull"})
 	bindings = append(bindings, help.KeyBinding{Key: "s", Description: "settings"})
 
 	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, 4) // Increased header count
 }
 
+// selectionBindings returns the help bindings shown while in select mode.
+func (r *Renderer) selectionBindings(actions []config.Action) []help.KeyBinding {
+	bindings := []help.KeyBinding{
+		{Key: "space", Description: "mark"},
+		{Key: "a/n", Description: "all/none"},
+		{Key: "d/u/b", Description: "dirty/unpushed/behind"},
+		{Key: "f", Description: "fetch"},
+		{Key: "P/U", Description: "push/pull"},
+	}
+	for _, action := range actions {
+		bindings = append(bindings, help.KeyBinding{Key: action.Key, Description: action.Description})
+	}
+	return append(bindings, help.KeyBinding{Key: "esc", Description: "done"})
+}
+
 // renderNavigableItemList renders a list of navigable items (repositories and worktrees).
-func (r *Renderer) renderNavigableItemList(items []types.NavigableItem, cursor int, width int) string {
+func (r *Renderer) renderNavigableItemList(items []types.NavigableItem, cursor int, width int, selection Selection) string {
 	var content string
 	i := 0
 
 	for i < len(items) {
 		item := items[i]
 
-		if item.Type == "repository" && item.Repository.IsBare {
-			// Start of bare repository group - collect all items in this group
-			groupContent := r.renderNavigableItem(item, i, cursor, width, false)
+		if item.Type == "repository" {
+			// Start of repository group - collect all items in this group
+			groupContent := r.renderNavigableItem(item, i, cursor, width, false, selection)
 
-			// Add all worktrees that belong to this bare repository
+			// Add all worktrees that belong to this repository
 			j := i + 1
 			worktreeStart := j
 			for j < len(items) && items[j].Type == "worktree" && items[j].ParentRepo.Path == item.Repository.Path {
@@ -112,7 +148,7 @@ func (r *Renderer) renderNavigableItemList(items []types.NavigableItem, cursor i
 			// Render worktrees with knowledge of which is last
 			for k := worktreeStart; k < worktreeEnd; k++ {
 				isLastWorktree := (k == worktreeEnd-1)
-				groupContent += "\n" + r.renderNavigableItem(items[k], k, cursor, width, isLastWorktree)
+				groupContent += "\n" + r.renderNavigableItem(items[k], k, cursor, width, isLastWorktree, selection)
 			}
 
 			// No border - just add the group content directly
@@ -121,8 +157,8 @@ func (r *Renderer) renderNavigableItemList(items []types.NavigableItem, cursor i
 			// Move index to after the group
 			i = j
 		} else {
-			// Regular item (non-bare repository or standalone worktree)
-			content += r.renderNavigableItem(item, i, cursor, width, false) + "\n"
+			// Worktree without its repository in front of it
+			content += r.renderNavigableItem(item, i, cursor, width, false, selection) + "\n"
 			i++
 		}
 	}
@@ -131,7 +167,7 @@ func (r *Renderer) renderNavigableItemList(items []types.NavigableItem, cursor i
 }
 
 // renderNavigableItem renders a single navigable item.
-func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor int, width int, isLastWorktree bool) string {
+func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor int, width int, isLastWorktree bool, selection Selection) string {
 	isSelected := index == cursor
 
 	var style = r.styles.Item
@@ -146,6 +182,7 @@ func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor i
 	} else {
 		frontIndicator = strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
 	}
+	frontIndicator += r.renderMark(item, selection)
 
 	switch item.Type {
 	case "repository":
@@ -167,12 +204,19 @@ func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor i
 			statusParts = append(statusParts, r.styles.StatusError.Render(r.theme.Indicators.Error))
 		} else if !repo.IsBare {
 			// Only show status for non-bare repositories
+			if repo.Operation.InProgress() {
+				style, indicator, _ := r.operationStatus(repo.Operation)
+				statusParts = append(statusParts, style.Render(indicator))
+			}
 			if repo.HasUncommitted {
 				statusParts = append(statusParts, r.styles.StatusUncommitted.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Dirty, repo.UncommittedCount)))
 			}
 			if repo.HasUnpushed {
 				statusParts = append(statusParts, r.styles.StatusUnpushed.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Unpushed, repo.UnpushedCount)))
 			}
+			if repo.HasBehind {
+				statusParts = append(statusParts, r.styles.StatusBehind.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Behind, repo.BehindCount)))
+			}
 			if repo.HasUntracked {
 				statusParts = append(statusParts, r.styles.StatusUntracked.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Untracked, repo.UntrackedCount)))
 			}
@@ -180,6 +224,12 @@ func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor i
 				statusParts = append(statusParts, r.styles.StatusClean.Render(r.theme.Indicators.Clean))
 			}
 		}
+		if repo.StashCount > 0 && !repo.IsBare && !repo.HasError {
+			statusParts = append(statusParts, r.styles.StatusStash.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Stash, repo.StashCount)))
+		}
+		if repo.FetchStale {
+			statusParts = append(statusParts, r.styles.StatusStale.Render(r.theme.Indicators.Stale))
+		}
 
 		// Build the main line with styled name if selected
 		var repoName string
@@ -189,6 +239,9 @@ func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor i
 			repoName = repo.Name
 		}
 		repoLine := fmt.Sprintf(" %s%s %s", frontIndicator, repoIcon, repoName)
+		if !repo.IsBare && !repo.HasError {
+			repoLine += " " + r.renderBranchInfo(repo.Branch, repo.Upstream, repo.Detached, repo.HeadCommit)
+		}
 
 		// Build status summary
 		statusSummary := strings.Join(statusParts, " ")
@@ -242,44 +295,66 @@ func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor i
 		}
 
 		// Build branch info
-		branchIcon := lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.Branch)).Render(r.theme.Icons.Branch.Icon)
-		branchName := lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.Branch)).Render(worktree.Branch)
-		branchInfo := fmt.Sprintf("%s%s", branchIcon, branchName)
+		branchInfo := r.renderBranchInfo(worktree.Branch, worktree.Upstream, worktree.Detached, worktree.HeadCommit)
 
 		// Find the corresponding SubItem for this worktree to get status and counts
-		var hasError, hasUncommitted, hasUnpushed, hasUntracked bool
-		var uncommittedCount, unpushedCount, untrackedCount int
+		var hasError, hasUncommitted, hasUnpushed, hasBehind, hasUntracked bool
+		var uncommittedCount, unpushedCount, behindCount, untrackedCount, stashCount int
+		var operation repomanager.Operation
+		var locked, prunable bool
 
 		for _, subItem := range parentRepo.SubItems {
 			if subItem.Path == worktree.Path {
 				hasError = subItem.HasError
 				hasUncommitted = subItem.HasUncommitted
 				hasUnpushed = subItem.HasUnpushed
+				hasBehind = subItem.HasBehind
 				hasUntracked = subItem.HasUntracked
 				uncommittedCount = subItem.UncommittedCount
 				unpushedCount = subItem.UnpushedCount
+				behindCount = subItem.BehindCount
 				untrackedCount = subItem.UntrackedCount
+				stashCount = subItem.StashCount
+				operation = subItem.Operation
+				locked = subItem.Locked
+				prunable = subItem.Prunable
 				break
 			}
 		}
 
-		// Build status summary for the end
+		// Build status summary for the end. A prunable worktree usually lost its
+		// directory, so its status cannot be read and the error is expected
 		var statusParts []string
-		if hasError {
+		if prunable {
+			statusParts = append(statusParts, r.styles.StatusStale.Render(r.theme.Indicators.Prunable))
+		} else if hasError {
 			statusParts = append(statusParts, r.styles.StatusError.Render(r.theme.Indicators.Error))
 		} else {
+			if operation.InProgress() {
+				style, indicator, _ := r.operationStatus(operation)
+				statusParts = append(statusParts, style.Render(indicator))
+			}
 			if hasUncommitted {
 				statusParts = append(statusParts, r.styles.StatusUncommitted.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Dirty, uncommittedCount)))
 			}
 			if hasUnpushed {
 				statusParts = append(statusParts, r.styles.StatusUnpushed.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Unpushed, unpushedCount)))
 			}
+			if hasBehind {
+				statusParts = append(statusParts, r.styles.StatusBehind.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Behind, behindCount)))
+			}
 			if hasUntracked {
 				statusParts = append(statusParts, r.styles.StatusUntracked.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Untracked, untrackedCount)))
 			}
 			if len(statusParts) == 0 {
 				statusParts = append(statusParts, r.styles.StatusClean.Render(r.theme.Indicators.Clean))
 			}
+			if stashCount > 0 {
+				statusParts = append(statusParts, r.styles.StatusStash.Render(fmt.Sprintf("%s%d", r.theme.Indicators.Stash, stashCount)))
+			}
+		}
+		if locked {
+			statusParts = append(statusParts, r.styles.StatusStale.Render(r.theme.Indicators.Locked))
 		}
 
 		// Build the main line with indentation for worktree and styled name if selected
@@ -332,6 +407,54 @@ func (r *Renderer) renderNavigableItem(item types.NavigableItem, index, cursor i
 	}
 }
 
+// renderMark renders the marker of a marked item in select mode, or blank space of
+// the same width for unmarked items. Outside select mode nothing is rendered.
+func (r *Renderer) renderMark(item types.NavigableItem, selection Selection) string {
+	if !selection.Active {
+		return ""
+	}
+	if selection.Marked[item.Path()] {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.Selected)).Render(r.theme.Indicators.Marked)
+	}
+	return strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Marked))
+}
+
+// renderBranchInfo renders the checked out branch with its upstream, the commit of a
+// detached HEAD, or a marker when the branch has no upstream configured.
+func (r *Renderer) renderBranchInfo(branch, upstream string, detached bool, headCommit string) string {
+	branchStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(r.theme.Colors.Branch))
+	branchIcon := branchStyle.Render(r.theme.Icons.Branch.Icon)
+
+	switch {
+	case detached:
+		return branchIcon + branchStyle.Render(headCommit) + r.styles.StatusNotAdded.Render(" (detached)")
+	case branch == "":
+		return ""
+	case upstream == "":
+		return branchIcon + branchStyle.Render(branch) + r.styles.StatusNotAdded.Render(" (no upstream)")
+	default:
+		return branchIcon + branchStyle.Render(branch) + r.styles.StatusNotAdded.Render(" → "+upstream)
+	}
+}
+
+// operationStatus returns the style, indicator and label for an in-progress operation.
+func (r *Renderer) operationStatus(operation repomanager.Operation) (lipgloss.Style, string, string) {
+	switch operation {
+	case repomanager.OperationMerge:
+		return r.styles.StatusMerging, r.theme.Indicators.Merging, "merging"
+	case repomanager.OperationRebase:
+		return r.styles.StatusRebasing, r.theme.Indicators.Rebasing, "rebasing"
+	case repomanager.OperationCherryPick:
+		return r.styles.StatusCherryPicking, r.theme.Indicators.CherryPicking, "cherry-picking"
+	case repomanager.OperationRevert:
+		return r.styles.StatusReverting, r.theme.Indicators.Reverting, "reverting"
+	case repomanager.OperationBisect:
+		return r.styles.StatusBisecting, r.theme.Indicators.Bisecting, "bisecting"
+	default:
+		return r.styles.Item, "", ""
+	}
+}
+
 // renderSummary renders a summary line showing repo/branch info and aggregated change counts
 func (r *Renderer) renderSummary(summaryData repomanager.SummaryData, width int) string {
 	// Build the summary line as a static table header with icons and padding
@@ -347,6 +470,21 @@ func (r *Renderer) renderSummary(summaryData repomanager.SummaryData, width int)
 	if summaryData.TotalUnpushed > 0 {
 		summaryParts = append(summaryParts, r.styles.StatusUnpushed.Render(fmt.Sprintf("%d unpushed", summaryData.TotalUnpushed)))
 	}
+	if summaryData.TotalBehind > 0 {
+		summaryParts = append(summaryParts, r.styles.StatusBehind.Render(fmt.Sprintf("%d behind", summaryData.TotalBehind)))
+	}
+	if summaryData.TotalStale > 0 {
+		summaryParts = append(summaryParts, r.styles.StatusStale.Render(fmt.Sprintf("%d stale", summaryData.TotalStale)))
+	}
+	for _, operation := range repomanager.Operations {
+		if count := summaryData.InProgress[operation]; count > 0 {
+			style, _, label := r.operationStatus(operation)
+			summaryParts = append(summaryParts, style.Render(fmt.Sprintf("%d %s", count, label)))
+		}
+	}
+	if summaryData.TotalStashes > 0 {
+		summaryParts = append(summaryParts, r.styles.StatusStash.Render(fmt.Sprintf("%d stashed", summaryData.TotalStashes)))
+	}
 	if summaryData.TotalUntracked > 0 {
 		summaryParts = append(summaryParts, r.styles.StatusUntracked.Render(fmt.Sprintf("%d untracked", summaryData.TotalUntracked)))
 	}
@@ -432,12 +570,19 @@ func (r *Renderer) renderRepositoryItem(repo *repomanager.RepoItem, index, curso
 		statusParts = append(statusParts, r.styles.StatusError.Render("error"))
 	} else if !repo.IsBare {
 		// Only show status for non-bare repositories
+		if repo.Operation.InProgress() {
+			style, _, label := r.operationStatus(repo.Operation)
+			statusParts = append(statusParts, style.Render(label))
+		}
 		if repo.HasUncommitted {
 			statusParts = append(statusParts, r.styles.StatusUncommitted.Render(fmt.Sprintf("%d uncommitted", repo.UncommittedCount)))
 		}
 		if repo.HasUnpushed {
 			statusParts = append(statusParts, r.styles.StatusUnpushed.Render(fmt.Sprintf("%d unpushed", repo.UnpushedCount)))
 		}
+		if repo.HasBehind {
+			statusParts = append(statusParts, r.styles.StatusBehind.Render(fmt.Sprintf("%d behind", repo.BehindCount)))
+		}
 		if repo.HasUntracked {
 			statusParts = append(statusParts, r.styles.StatusUntracked.Render(fmt.Sprintf("%d untracked", repo.UntrackedCount)))
 		}
@@ -445,6 +590,12 @@ func (r *Renderer) renderRepositoryItem(repo *repomanager.RepoItem, index, curso
 			statusParts = append(statusParts, r.styles.StatusClean.Render("clean"))
 		}
 	}
+	if repo.StashCount > 0 && !repo.IsBare && !repo.HasError {
+		statusParts = append(statusParts, r.styles.StatusStash.Render(fmt.Sprintf("%d stashed", repo.StashCount)))
+	}
+	if repo.FetchStale {
+		statusParts = append(statusParts, r.styles.StatusStale.Render("stale"))
+	}
 
 	// Build the main line with styled name if selected
 	var repoName string
@@ -454,6 +605,9 @@ func (r *Renderer) renderRepositoryItem(repo *repomanager.RepoItem, index, curso
 		repoName = repo.Name
 	}
 	repoLine := fmt.Sprintf(" %s%s %s", frontIndicator, repoIcon, repoName)
+	if !repo.IsBare && !repo.HasError {
+		repoLine += " " + r.renderBranchInfo(repo.Branch, repo.Upstream, repo.Detached, repo.HeadCommit)
+	}
 
 	// Build status summary
 	statusSummary := strings.Join(statusParts, " ")
@@ -7,20 +7,28 @@ import (
 
 // StyleConfig contains all the styles needed for home page rendering
 type StyleConfig struct {
-	Item              lipgloss.Style
-	SelectedItem      lipgloss.Style
-	StatusUncommitted lipgloss.Style
-	StatusUnpushed    lipgloss.Style
-	StatusUntracked   lipgloss.Style
-	StatusError       lipgloss.Style
-	StatusClean       lipgloss.Style
-	StatusNotAdded    lipgloss.Style
-	Help              lipgloss.Style
-	Branch            lipgloss.Style
-	Border            lipgloss.Style
-	IconRegular       lipgloss.Style
-	IconBare          lipgloss.Style
-	IconWorktree      lipgloss.Style
+	Item                lipgloss.Style
+	SelectedItem        lipgloss.Style
+	StatusUncommitted   lipgloss.Style
+	StatusUnpushed      lipgloss.Style
+	StatusBehind        lipgloss.Style
+	StatusStale         lipgloss.Style
+	StatusStash         lipgloss.Style
+	StatusMerging       lipgloss.Style
+	StatusRebasing      lipgloss.Style
+	StatusCherryPicking lipgloss.Style
+	StatusReverting     lipgloss.Style
+	StatusBisecting     lipgloss.Style
+	StatusUntracked     lipgloss.Style
+	StatusError         lipgloss.Style
+	StatusClean         lipgloss.Style
+	StatusNotAdded      lipgloss.Style
+	Help                lipgloss.Style
+	Branch              lipgloss.Style
+	Border              lipgloss.Style
+	IconRegular         lipgloss.Style
+	IconBare            lipgloss.Style
+	IconWorktree        lipgloss.Style
 }
 
 // CreateStyleConfig creates a style configuration for the home page
@@ -36,6 +44,28 @@ func CreateStyleConfig(themeConfig theme.Theme) StyleConfig {
 		StatusUnpushed: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(themeConfig.Colors.StatusUnpushed)).
 			Bold(true),
+		StatusBehind: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusBehind)).
+			Bold(true),
+		StatusStale: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusStale)),
+		StatusStash: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusStash)),
+		StatusMerging: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusMerging)).
+			Bold(true),
+		StatusRebasing: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusRebasing)).
+			Bold(true),
+		StatusCherryPicking: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusCherryPicking)).
+			Bold(true),
+		StatusReverting: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusReverting)).
+			Bold(true),
+		StatusBisecting: lipgloss.NewStyle().
+			Foreground(lipgloss.Color(themeConfig.Colors.StatusBisecting)).
+			Bold(true),
 		StatusUntracked: lipgloss.NewStyle().
 			Foreground(lipgloss.Color(themeConfig.Colors.StatusUntracked)).
 			Bold(true),
@@ -0,0 +1,86 @@
+// Package remote renders the output of a push or pull of a repository or worktree.
+package remote
+
+import (
+	"strings"
+
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the output.
+const headerLines = 2
+
+// RemoteData holds the output and state of the push or pull.
+type RemoteData struct {
+	Name    string // Name of the repository or worktree
+	Action  string // "push" or "pull"
+	Output  []repomanager.OutputLine
+	Running bool
+	Failed  bool
+	Status  string // Result once the command finished
+}
+
+// Renderer handles rendering of the push and pull page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new push and pull page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many output lines fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, result line and help line
+	return max(height-headerLines-3, 5)
+}
+
+// Render renders the most recent output lines followed by the result.
+func (r *Renderer) Render(data RemoteData, width, height int) string {
+	state := "succeeded"
+	switch {
+	case data.Running:
+		state = "running..."
+	case data.Failed:
+		state = "failed"
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", data.Name+" "+data.Action, state, len(data.Output), width) + "\n"
+
+	// Keep the end of the output in view, like a terminal would
+	output := data.Output[max(len(data.Output)-VisibleRows(height), 0):]
+	lineWidth := max(width-2, 10)
+
+	var lines []string
+	for _, line := range output {
+		text := []rune(line.Text)
+		lines = append(lines, r.styles.Output.Render(string(text[:min(lineWidth, len(text))])))
+	}
+
+	switch {
+	case data.Running:
+		lines = append(lines, r.styles.Running.Render("Running git "+data.Action+"..."))
+	case data.Failed:
+		lines = append(lines, r.styles.Failure.Render(data.Status))
+	default:
+		lines = append(lines, r.styles.Success.Render(data.Status))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	var bindings []help.KeyBinding
+	if data.Running {
+		bindings = append(bindings, help.KeyBinding{Key: "x", Description: "cancel"})
+	}
+	bindings = append(bindings, help.KeyBinding{Key: "b", Description: "back"})
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
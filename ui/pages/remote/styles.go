@@ -0,0 +1,12 @@
+package remote
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the push and pull page
+type StyleConfig struct {
+	Output  lipgloss.Style
+	Success lipgloss.Style
+	Failure lipgloss.Style
+	Running lipgloss.Style
+	Help    lipgloss.Style
+}
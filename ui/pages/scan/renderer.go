@@ -0,0 +1,147 @@
+// Package scan renders the repository discovery scan and the review of its results.
+package scan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the result list.
+const headerLines = 2
+
+// kindWidth is the width of the repository kind column.
+const kindWidth = 11
+
+// ScanData holds the results and the state of the scan page.
+type ScanData struct {
+	Roots    string // Directories to scan, separated by commas
+	Editing  bool   // Whether the directories are being typed
+	Results  []repomanager.ScanResult
+	Checked  []bool // Whether each result is checked for adding
+	Cursor   int    // Index of the selected result
+	Offset   int    // Index of the first visible result
+	Scanning bool   // Whether the scan is running
+	Adding   bool   // Whether the checked results are being added
+	Scanned  bool   // Whether a scan finished, so an empty result means nothing was found
+	Status   string // Result of the last scan or add, or a hint
+}
+
+// Renderer handles rendering of the repository scan page
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new repository scan page renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many results fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, directories, status line and help line
+	return max(height-headerLines-4, 5)
+}
+
+// Render renders the directories to scan followed by the results found in them.
+func (r *Renderer) Render(data ScanData, width, height int) string {
+	checked := 0
+	for _, c := range data.Checked {
+		if c {
+			checked++
+		}
+	}
+	status := fmt.Sprintf("%d checked", checked)
+	switch {
+	case data.Scanning:
+		status = "scanning..."
+	case data.Adding:
+		status = "adding..."
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", "repository scan", status, len(data.Results), width) + "\n"
+
+	var lines []string
+	if data.Editing {
+		lines = append(lines, r.styles.Input.Render("Directories to scan: "+data.Roots+"█"))
+	} else {
+		lines = append(lines, r.styles.Muted.Render("Directories to scan: "+data.Roots))
+	}
+	switch {
+	case data.Status != "":
+		lines = append(lines, r.styles.Warning.Render(data.Status))
+	case data.Scanned && len(data.Results) == 0 && !data.Scanning:
+		lines = append(lines, r.styles.Item.Render("No repositories found"))
+	}
+
+	end := min(data.Offset+VisibleRows(height), len(data.Results))
+	for i := max(data.Offset, 0); i < end; i++ {
+		lines = append(lines, r.renderResult(data.Results[i], i < len(data.Checked) && data.Checked[i], i == data.Cursor && !data.Editing, width))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "Enter", Description: "scan"},
+		{Key: "Esc", Description: "cancel"},
+	}
+	switch {
+	case data.Scanning:
+		bindings = []help.KeyBinding{
+			{Key: "x", Description: "cancel scan"},
+			{Key: "b", Description: "back"},
+		}
+	case !data.Editing:
+		bindings = []help.KeyBinding{
+			{Key: "j/k", Description: "move"},
+			{Key: "space", Description: "check"},
+			{Key: "a/n", Description: "all/none"},
+			{Key: "Enter", Description: "add checked"},
+			{Key: "e", Description: "edit directories"},
+			{Key: "r", Description: "rescan"},
+			{Key: "b", Description: "back"},
+		}
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderResult renders a single result: check marker, kind and path, with results
+// already in the list dimmed.
+func (r *Renderer) renderResult(result repomanager.ScanResult, checked, selected bool, width int) string {
+	front := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
+	if selected {
+		front = r.styles.Selected.Render(r.theme.Indicators.Selected)
+	}
+	mark := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Marked))
+	if checked {
+		mark = r.styles.Marked.Render(r.theme.Indicators.Marked)
+	}
+
+	pathStyle := r.styles.Item
+	switch {
+	case selected:
+		pathStyle = r.styles.Selected
+	case result.Tracked:
+		pathStyle = r.styles.Muted
+	}
+	kind := r.styles.Kind.Render(fmt.Sprintf("%-*s", kindWidth, result.Kind))
+
+	line := front + mark + kind + " " + pathStyle.Render(result.Path)
+	if result.Tracked {
+		line += " " + r.styles.Muted.Render("(added)")
+	}
+	if lipgloss.Width(line) > width-2 {
+		line = lipgloss.NewStyle().MaxWidth(max(width-2, 0)).Render(line)
+	}
+	return line
+}
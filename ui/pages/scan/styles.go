@@ -0,0 +1,15 @@
+package scan
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the repository scan page
+type StyleConfig struct {
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Marked   lipgloss.Style
+	Kind     lipgloss.Style
+	Muted    lipgloss.Style
+	Input    lipgloss.Style
+	Warning  lipgloss.Style
+	Help     lipgloss.Style
+}
@@ -198,6 +198,10 @@ func (r *Renderer) getAllThemeItems(themeConfig theme.Theme) []ThemeItem {
 		{"Regular Icon", themeConfig.Colors.IconRegular, "color", "Colors"},
 		{"Bare Icon", themeConfig.Colors.IconBare, "color", "Colors"},
 		{"Worktree Icon", themeConfig.Colors.IconWorktree, "color", "Colors"},
+		{"Diff Added", themeConfig.Colors.DiffAdded, "color", "Colors"},
+		{"Diff Removed", themeConfig.Colors.DiffRemoved, "color", "Colors"},
+		{"Diff Hunk", themeConfig.Colors.DiffHunk, "color", "Colors"},
+		{"Diff Header", themeConfig.Colors.DiffHeader, "color", "Colors"},
 	}...)
 
 	// Status colors and indicators
@@ -208,6 +212,24 @@ func (r *Renderer) getAllThemeItems(themeConfig theme.Theme) []ThemeItem {
 		{"Dirty Status Icon", themeConfig.Indicators.Dirty, "indicator", "Status Indicators"},
 		{"Unpushed Status Color", themeConfig.Colors.StatusUnpushed, "color", "Status Indicators"},
 		{"Unpushed Status Icon", themeConfig.Indicators.Unpushed, "indicator", "Status Indicators"},
+		{"Behind Status Color", themeConfig.Colors.StatusBehind, "color", "Status Indicators"},
+		{"Behind Status Icon", themeConfig.Indicators.Behind, "indicator", "Status Indicators"},
+		{"Stale Fetch Color", themeConfig.Colors.StatusStale, "color", "Status Indicators"},
+		{"Stale Fetch Icon", themeConfig.Indicators.Stale, "indicator", "Status Indicators"},
+		{"Stash Status Color", themeConfig.Colors.StatusStash, "color", "Status Indicators"},
+		{"Stash Status Icon", themeConfig.Indicators.Stash, "indicator", "Status Indicators"},
+		{"Locked Worktree Icon", themeConfig.Indicators.Locked, "indicator", "Status Indicators"},
+		{"Prunable Worktree Icon", themeConfig.Indicators.Prunable, "indicator", "Status Indicators"},
+		{"Merge In Progress Color", themeConfig.Colors.StatusMerging, "color", "Status Indicators"},
+		{"Merge In Progress Icon", themeConfig.Indicators.Merging, "indicator", "Status Indicators"},
+		{"Rebase In Progress Color", themeConfig.Colors.StatusRebasing, "color", "Status Indicators"},
+		{"Rebase In Progress Icon", themeConfig.Indicators.Rebasing, "indicator", "Status Indicators"},
+		{"Cherry-pick In Progress Color", themeConfig.Colors.StatusCherryPicking, "color", "Status Indicators"},
+		{"Cherry-pick In Progress Icon", themeConfig.Indicators.CherryPicking, "indicator", "Status Indicators"},
+		{"Revert In Progress Color", themeConfig.Colors.StatusReverting, "color", "Status Indicators"},
+		{"Revert In Progress Icon", themeConfig.Indicators.Reverting, "indicator", "Status Indicators"},
+		{"Bisect In Progress Color", themeConfig.Colors.StatusBisecting, "color", "Status Indicators"},
+		{"Bisect In Progress Icon", themeConfig.Indicators.Bisecting, "indicator", "Status Indicators"},
 		{"Untracked Status Color", themeConfig.Colors.StatusUntracked, "color", "Status Indicators"},
 		{"Untracked Status Icon", themeConfig.Indicators.Untracked, "indicator", "Status Indicators"},
 		{"Error Status Color", themeConfig.Colors.StatusError, "color", "Status Indicators"},
@@ -227,6 +249,7 @@ func (r *Renderer) getAllThemeItems(themeConfig theme.Theme) []ThemeItem {
 	items = append(items, []ThemeItem{
 		{"Selected Indicator", themeConfig.Indicators.Selected, "indicator", "UI Icons"},
 		{"Selected End", themeConfig.Indicators.SelectedEnd, "indicator", "UI Icons"},
+		{"Marked Indicator", themeConfig.Indicators.Marked, "indicator", "UI Icons"},
 		{"Branch Icon", themeConfig.Icons.Branch.Icon, "icon", "UI Icons"},
 		{"Tree Branch", themeConfig.Icons.Tree.Branch, "icon", "UI Icons"},
 		{"Tree Last", themeConfig.Icons.Tree.Last, "icon", "UI Icons"},
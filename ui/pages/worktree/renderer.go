@@ -0,0 +1,132 @@
+// Package worktree renders the dialog creating a worktree for a repository.
+package worktree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the branch list.
+const headerLines = 2
+
+// nameWidth is the width of the branch name column.
+const nameWidth = 32
+
+// WorktreeData holds the branches and the state of the new worktree dialog.
+type WorktreeData struct {
+	Name        string // Name of the repository
+	Branches    []repomanager.Branch
+	Cursor      int    // Index of the selected branch
+	Offset      int    // Index of the first visible branch
+	Loading     bool   // Whether the branches are being read
+	InputPrompt string // Prompt of the branch name or path being typed, empty when picking a branch
+	Input       string // Text typed so far
+	Status      string // Result of the last attempt or a hint
+}
+
+// Renderer handles rendering of the new worktree dialog
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new worktree dialog renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many branches fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, status, input line and help line
+	return max(height-headerLines-4, 5)
+}
+
+// Render renders the branches to create the worktree from, with the branch name
+// or path being typed above them.
+func (r *Renderer) Render(data WorktreeData, width, height int) string {
+	status := ""
+	if data.Loading {
+		status = "loading..."
+	}
+	content := r.header.RenderWithStatusAndSpacing("git-dash", "New worktree for "+data.Name, status, len(data.Branches), width) + "\n"
+
+	var lines []string
+	if data.Status != "" {
+		lines = append(lines, r.styles.Error.Render(data.Status))
+	}
+	switch {
+	case data.InputPrompt != "":
+		lines = append(lines, r.styles.Input.Render(data.InputPrompt+": "+data.Input+"█"))
+	case len(data.Branches) == 0 && !data.Loading:
+		lines = append(lines, r.styles.Item.Render("No branches yet, press n to create one"))
+	}
+
+	end := min(data.Offset+VisibleRows(height), len(data.Branches))
+	for i := max(data.Offset, 0); i < end; i++ {
+		lines = append(lines, r.renderBranch(data.Branches[i], i == data.Cursor, width))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "Enter", Description: "confirm"},
+		{Key: "Esc", Description: "cancel"},
+	}
+	if data.InputPrompt == "" {
+		bindings = []help.KeyBinding{
+			{Key: "j/k", Description: "move"},
+			{Key: "Enter", Description: "use branch"},
+			{Key: "n", Description: "new from selected"},
+			{Key: "b", Description: "back"},
+		}
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderBranch renders a single branch line with the worktree it is checked out in.
+func (r *Renderer) renderBranch(branch repomanager.Branch, selected bool, width int) string {
+	front := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
+	if selected {
+		front = r.styles.Selected.Render(r.theme.Indicators.Selected)
+	}
+
+	nameStyle := r.styles.Item
+	switch {
+	case selected:
+		nameStyle = r.styles.Selected
+	case branch.Remote:
+		nameStyle = r.styles.Remote
+	}
+	line := front + nameStyle.Render(fmt.Sprintf("%-*s", nameWidth, truncate(branch.Name, nameWidth)))
+
+	if branch.Worktree != "" {
+		line += " " + r.styles.Muted.Render(r.theme.Icons.Repository.Worktree+branch.Worktree)
+	}
+	if lipgloss.Width(line) > width-2 {
+		line = lipgloss.NewStyle().MaxWidth(max(width-2, 0)).Render(line)
+	}
+	return line
+}
+
+// truncate shortens text to width runes, ending in an ellipsis when cut.
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
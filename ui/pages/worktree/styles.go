@@ -0,0 +1,14 @@
+package worktree
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the new worktree dialog
+type StyleConfig struct {
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Remote   lipgloss.Style
+	Muted    lipgloss.Style
+	Input    lipgloss.Style
+	Error    lipgloss.Style
+	Help     lipgloss.Style
+}
@@ -0,0 +1,148 @@
+// Package worktrees renders the linked worktrees of a repository.
+package worktrees
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/components/help"
+	"github.com/jarmocluyse/git-dash/ui/header"
+)
+
+// headerLines is the number of lines above the worktree list.
+const headerLines = 2
+
+// nameWidth is the width of the worktree name column.
+const nameWidth = 24
+
+// WorktreesData holds the worktrees and the state of the worktree list.
+type WorktreesData struct {
+	Name        string // Name of the repository
+	Worktrees   []*repomanager.SubItem
+	Cursor      int    // Index of the selected worktree
+	Offset      int    // Index of the first visible worktree
+	InputPrompt string // Prompt of the lock reason being typed, empty when not typing
+	Input       string // Lock reason typed so far
+	Status      string // Result of the last action or a confirmation question
+}
+
+// Renderer handles rendering of the worktree list
+type Renderer struct {
+	styles StyleConfig
+	theme  theme.Theme
+	header *header.Renderer
+}
+
+// NewRenderer creates a new worktree list renderer
+func NewRenderer(styles StyleConfig, themeConfig theme.Theme) *Renderer {
+	return &Renderer{
+		styles: styles,
+		theme:  themeConfig,
+		header: header.NewRenderer(themeConfig),
+	}
+}
+
+// VisibleRows returns how many worktrees fit on a page of the given height.
+func VisibleRows(height int) int {
+	// Header, blank line, status or input line and help line
+	return max(height-headerLines-3, 5)
+}
+
+// Render renders the worktrees with the selected one highlighted.
+func (r *Renderer) Render(data WorktreesData, width, height int) string {
+	content := r.header.RenderWithStatusAndSpacing("git-dash", data.Name+" worktrees", "", len(data.Worktrees), width) + "\n"
+
+	var lines []string
+	switch {
+	case data.InputPrompt != "":
+		lines = append(lines, r.styles.Input.Render(data.InputPrompt+": "+data.Input+"█"))
+	case data.Status != "":
+		lines = append(lines, r.styles.Error.Render(data.Status))
+	case len(data.Worktrees) == 0:
+		lines = append(lines, r.styles.Item.Render("No linked worktrees"))
+	}
+
+	end := min(data.Offset+VisibleRows(height), len(data.Worktrees))
+	for i := max(data.Offset, 0); i < end; i++ {
+		lines = append(lines, r.renderWorktree(data.Worktrees[i], i == data.Cursor, width))
+	}
+	content += strings.Join(lines, "\n")
+
+	helpBuilder := help.NewBuilder(r.styles.Help)
+	bindings := []help.KeyBinding{
+		{Key: "Enter", Description: "lock"},
+		{Key: "Esc", Description: "cancel"},
+	}
+	if data.InputPrompt == "" {
+		bindings = []help.KeyBinding{
+			{Key: "j/k", Description: "move"},
+			{Key: "d", Description: "remove"},
+			{Key: "p", Description: "prune"},
+			{Key: "L", Description: "lock/unlock"},
+			{Key: "b", Description: "back"},
+		}
+	}
+	return helpBuilder.RenderWithBottomHelpAndHeader(content, bindings, width, height, headerLines)
+}
+
+// renderWorktree renders a single worktree line: name, checked out branch and
+// its uncommitted, locked and prunable state.
+func (r *Renderer) renderWorktree(worktree *repomanager.SubItem, selected bool, width int) string {
+	front := strings.Repeat(" ", lipgloss.Width(r.theme.Indicators.Selected))
+	nameStyle := r.styles.Item
+	if selected {
+		front = r.styles.Selected.Render(r.theme.Indicators.Selected)
+		nameStyle = r.styles.Selected
+	}
+
+	head := worktree.Branch
+	if worktree.Detached {
+		head = "detached " + worktree.HeadCommit
+	}
+
+	line := front + r.styles.Icon.Render(r.theme.Icons.Repository.Worktree) +
+		nameStyle.Render(fmt.Sprintf("%-*s", nameWidth, truncate(worktree.Name, nameWidth))) + " " +
+		r.styles.Branch.Render(head)
+
+	var states []string
+	if worktree.HasUncommitted || worktree.HasUntracked {
+		states = append(states, r.styles.Dirty.Render(r.theme.Indicators.Dirty))
+	}
+	if worktree.Locked {
+		states = append(states, r.styles.State.Render(withReason(r.theme.Indicators.Locked+"locked", worktree.LockReason)))
+	}
+	if worktree.Prunable {
+		states = append(states, r.styles.State.Render(withReason(r.theme.Indicators.Prunable+"prunable", worktree.PruneReason)))
+	}
+	if len(states) > 0 {
+		line += " " + strings.Join(states, " ")
+	}
+
+	if lipgloss.Width(line) > width-2 {
+		line = lipgloss.NewStyle().MaxWidth(max(width-2, 0)).Render(line)
+	}
+	return line
+}
+
+// withReason appends a reason in parentheses to text when one is given.
+func withReason(text, reason string) string {
+	if reason == "" {
+		return text
+	}
+	return text + " (" + reason + ")"
+}
+
+// truncate shortens text to width runes, ending in an ellipsis when cut.
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,16 @@
+package worktrees
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleConfig holds the styling configuration for the worktree list
+type StyleConfig struct {
+	Item     lipgloss.Style
+	Selected lipgloss.Style
+	Icon     lipgloss.Style
+	Branch   lipgloss.Style
+	Dirty    lipgloss.Style
+	State    lipgloss.Style
+	Input    lipgloss.Style
+	Error    lipgloss.Style
+	Help     lipgloss.Style
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+)
+
+// RefreshTick indicates that the automatic refresh interval has elapsed.
+type RefreshTick struct{}
+
+// scheduleRefreshTick arms the next automatic refresh, or does nothing when
+// refresh_interval is not set.
+func (m Model) scheduleRefreshTick() tea.Cmd {
+	if m.Config.RefreshInterval <= 0 {
+		return nil
+	}
+
+	return tea.Tick(m.Config.RefreshInterval, func(time.Time) tea.Msg {
+		return RefreshTick{}
+	})
+}
+
+// requestRefresh starts a status refresh unless one is still running, in which
+// case a single follow-up refresh is queued for when it finishes.
+func (m Model) requestRefresh() (Model, tea.Cmd) {
+	if m.Refreshing {
+		m.RefreshQueued = true
+		return m, nil
+	}
+
+	m.Refreshing = true
+	return m, m.updateRepositoryStatuses()
+}
+
+// handleRefreshTick starts an automatic refresh and arms the next tick. Ticks that
+// arrive while paused or while a refresh is still running are skipped rather than
+// queued, so a slow refresh never causes a backlog.
+func (m Model) handleRefreshTick(msg RefreshTick) (tea.Model, tea.Cmd) {
+	if m.RefreshPaused || m.Refreshing {
+		return m, m.scheduleRefreshTick()
+	}
+
+	m, cmd := m.requestRefresh()
+	return m, tea.Batch(cmd, m.scheduleRefreshTick())
+}
+
+// toggleRefreshPause pauses or resumes automatic refreshing. Manual refreshes,
+// background fetches and live updates keep working while paused.
+func (m Model) toggleRefreshPause() Model {
+	m.RefreshPaused = !m.RefreshPaused
+	return m
+}
+
+// refreshStatusText describes the automatic refresh state for the header.
+func (m Model) refreshStatusText() string {
+	status := "refreshing..."
+	if !m.LastRefresh.IsZero() {
+		status = "refreshed " + m.LastRefresh.Format("15:04:05")
+	}
+
+	if m.RefreshPaused && m.Config.RefreshInterval > 0 {
+		status += " (paused)"
+	}
+	return status
+}
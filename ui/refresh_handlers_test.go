@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	themeService "github.com/jarmocluyse/git-dash/internal/services/theme"
+)
+
+// stubDependencies satisfies Dependencies without a repository manager; the
+// refresh commands are only built, never run.
+type stubDependencies struct{}
+
+func (stubDependencies) GetConfigService() config.ConfigService   { return nil }
+func (stubDependencies) GetRepoManager() *repomanager.RepoManager { return nil }
+func (stubDependencies) GetThemeService() themeService.Service    { return nil }
+
+func newRefreshModel(interval time.Duration) Model {
+	return Model{
+		Dependencies: stubDependencies{},
+		Config:       &config.Config{RefreshInterval: interval},
+	}
+}
+
+func TestRequestRefresh_QueuesWhileRunning(t *testing.T) {
+	m, cmd := newRefreshModel(time.Minute).requestRefresh()
+	if cmd == nil || !m.Refreshing {
+		t.Fatal("expected first request to start a refresh")
+	}
+
+	m, cmd = m.requestRefresh()
+	if cmd != nil {
+		t.Fatal("expected second request not to start an overlapping refresh")
+	}
+	if !m.RefreshQueued {
+		t.Fatal("expected second request to be queued")
+	}
+
+	updated, cmd := m.handleStatusUpdate(StatusUpdateComplete{})
+	m = updated.(Model)
+	if cmd == nil || !m.Refreshing || m.RefreshQueued {
+		t.Fatal("expected queued refresh to start once the running one completed")
+	}
+	if m.LastRefresh.IsZero() {
+		t.Fatal("expected completion time to be recorded")
+	}
+
+	updated, cmd = m.handleStatusUpdate(StatusUpdateComplete{})
+	m = updated.(Model)
+	if cmd != nil || m.Refreshing {
+		t.Fatal("expected no further refresh without a queued request")
+	}
+}
+
+func TestHandleRefreshTick(t *testing.T) {
+	tests := []struct {
+		name       string
+		paused     bool
+		refreshing bool
+		wantStart  bool
+	}{
+		{name: "idle", wantStart: true},
+		{name: "paused", paused: true},
+		{name: "refresh running", refreshing: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newRefreshModel(time.Minute)
+			m.RefreshPaused = tt.paused
+			m.Refreshing = tt.refreshing
+
+			updated, cmd := m.handleRefreshTick(RefreshTick{})
+			got := updated.(Model)
+			if cmd == nil {
+				t.Fatal("expected the next tick to be scheduled")
+			}
+			if started := !tt.refreshing && got.Refreshing; started != tt.wantStart {
+				t.Errorf("refresh started = %v, want %v", started, tt.wantStart)
+			}
+			if got.RefreshQueued {
+				t.Error("ticks must not queue refreshes")
+			}
+		})
+	}
+}
+
+func TestScheduleRefreshTick_Disabled(t *testing.T) {
+	if cmd := newRefreshModel(0).scheduleRefreshTick(); cmd != nil {
+		t.Fatal("expected no tick when refresh_interval is 0")
+	}
+}
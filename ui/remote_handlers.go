@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// remoteOutputLimit is the number of output lines kept for the push and pull page.
+const remoteOutputLimit = 1000
+
+// RemoteOutput carries a line printed by the running push or pull.
+type RemoteOutput struct {
+	Path string
+	Line repomanager.OutputLine
+}
+
+// RemoteComplete indicates that the running push or pull finished.
+type RemoteComplete struct {
+	Path   string
+	Action string // "push" or "pull"
+	Err    error
+}
+
+// startRemote pushes or pulls the item in the background and shows its output.
+// Only one push or pull runs at a time; while one is running its output is shown instead.
+func (m Model) startRemote(item types.NavigableItem, action string) (Model, tea.Cmd) {
+	if m.RemoteRunning {
+		if m.State != RemoteView {
+			m.RemoteReturnState = m.State
+		}
+		m.State = RemoteView
+		return m, nil
+	}
+	if !hasWorkingTree(item) {
+		return m, nil
+	}
+
+	path := item.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan tea.Msg, 64)
+
+	go func() {
+		defer close(events)
+		output := func(line repomanager.OutputLine) {
+			events <- RemoteOutput{Path: path, Line: line}
+		}
+
+		var err error
+		switch action {
+		case "push":
+			err = repoManager.Push(ctx, path, output)
+		case "pull":
+			err = repoManager.Pull(ctx, path, output)
+		}
+		if errors.Is(ctx.Err(), context.Canceled) {
+			err = context.Canceled
+		}
+		events <- RemoteComplete{Path: path, Action: action, Err: err}
+	}()
+
+	m.RemoteReturnState = m.State
+	m.State = RemoteView
+	m.RemoteName = item.Name()
+	m.RemoteAction = action
+	m.RemoteOutput = nil
+	m.RemoteRunning = true
+	m.RemoteFailed = false
+	m.RemoteStatus = ""
+	m.RemoteEvents = events
+	m.RemoteCancel = cancel
+	return m, m.waitForRemoteEvent()
+}
+
+// waitForRemoteEvent waits for the next output line or the completion of the running command.
+func (m Model) waitForRemoteEvent() tea.Cmd {
+	events := m.RemoteEvents
+	if events == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		return <-events
+	}
+}
+
+// handleRemoteOutput appends a line of output, replacing the previous line when
+// that was a progress update, and waits for the next event.
+func (m Model) handleRemoteOutput(msg RemoteOutput) (tea.Model, tea.Cmd) {
+	output := m.RemoteOutput
+	if len(output) > 0 && output[len(output)-1].Progress {
+		output = output[:len(output)-1]
+	}
+	output = append(output, msg.Line)
+	if len(output) > remoteOutputLimit {
+		output = output[len(output)-remoteOutputLimit:]
+	}
+
+	m.RemoteOutput = output
+	return m, m.waitForRemoteEvent()
+}
+
+// handleRemoteComplete records the outcome of the push or pull and refreshes
+// statuses, since ahead and behind counts changed.
+func (m Model) handleRemoteComplete(msg RemoteComplete) (tea.Model, tea.Cmd) {
+	if m.RemoteCancel != nil {
+		m.RemoteCancel()
+	}
+	m.RemoteRunning = false
+	m.RemoteEvents = nil
+	m.RemoteCancel = nil
+
+	switch {
+	case errors.Is(msg.Err, context.Canceled):
+		m.RemoteFailed = true
+		m.RemoteStatus = fmt.Sprintf("Git %s cancelled", msg.Action)
+	case msg.Err != nil:
+		logging.Get().Error("remote operation failed", "action", msg.Action, "path", msg.Path, "error", msg.Err)
+		m.RemoteFailed = true
+		m.RemoteStatus = fmt.Sprintf("Git %s failed: %v", msg.Action, msg.Err)
+	default:
+		m.RemoteStatus = fmt.Sprintf("Git %s succeeded", msg.Action)
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, refresh
+}
+
+// cancelRemote stops the running push or pull; its completion is still reported.
+func (m Model) cancelRemote() Model {
+	if m.RemoteRunning && m.RemoteCancel != nil {
+		m.RemoteCancel()
+	}
+	return m
+}
+
+// closeRemote returns to the view the push or pull was started from. A running
+// command keeps going and can be shown again by starting another one.
+func (m Model) closeRemote() Model {
+	m.State = m.RemoteReturnState
+	return m
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+func TestHandleRemoteOutput_ReplacesProgress(t *testing.T) {
+	m := Model{RemoteRunning: true, RemoteEvents: make(chan tea.Msg)}
+	lines := []repomanager.OutputLine{
+		{Text: "Writing objects: 50%", Progress: true},
+		{Text: "Writing objects: 100%", Progress: true},
+		{Text: "Writing objects: 100%, done."},
+		{Text: "To origin"},
+	}
+	for _, line := range lines {
+		updated, cmd := m.handleRemoteOutput(RemoteOutput{Path: "/repo", Line: line})
+		m = updated.(Model)
+		if cmd == nil {
+			t.Fatal("expected to wait for the next event")
+		}
+	}
+
+	if len(m.RemoteOutput) != 2 || m.RemoteOutput[0].Text != "Writing objects: 100%, done." {
+		t.Errorf("unexpected output %+v", m.RemoteOutput)
+	}
+}
+
+func TestHandleRemoteComplete(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantFailed bool
+		wantStatus string
+	}{
+		{name: "success", wantStatus: "Git push succeeded"},
+		{name: "failure", err: errors.New("rejected"), wantFailed: true, wantStatus: "Git push failed: rejected"},
+		{name: "cancelled", err: context.Canceled, wantFailed: true, wantStatus: "Git push cancelled"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newRefreshModel(0)
+			m.State = RemoteView
+			m.RemoteRunning = true
+
+			updated, cmd := m.handleRemoteComplete(RemoteComplete{Path: "/repo", Action: "push", Err: tt.err})
+			got := updated.(Model)
+			if got.RemoteRunning || got.RemoteEvents != nil || cmd == nil {
+				t.Error("expected the command to be finished and statuses refreshed")
+			}
+			if got.RemoteFailed != tt.wantFailed || got.RemoteStatus != tt.wantStatus {
+				t.Errorf("failed = %v, status = %q", got.RemoteFailed, got.RemoteStatus)
+			}
+		})
+	}
+}
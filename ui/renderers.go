@@ -18,20 +18,28 @@ type ListViewRenderer struct {
 // NewListViewRenderer creates a new list view renderer with the given styles and theme.
 func NewListViewRenderer(styles StyleConfig, themeConfig theme.Theme) *ListViewRenderer {
 	homeStyles := home.StyleConfig{
-		Item:              styles.Item,
-		SelectedItem:      styles.SelectedItem,
-		StatusUncommitted: styles.StatusUncommitted,
-		StatusUnpushed:    styles.StatusUnpushed,
-		StatusUntracked:   styles.StatusUntracked,
-		StatusError:       styles.StatusError,
-		StatusClean:       styles.StatusClean,
-		StatusNotAdded:    styles.StatusNotAdded,
-		Help:              styles.Help,
-		Branch:            styles.Branch,
-		Border:            styles.Border,
-		IconRegular:       styles.IconRegular,
-		IconBare:          styles.IconBare,
-		IconWorktree:      styles.IconWorktree,
+		Item:                styles.Item,
+		SelectedItem:        styles.SelectedItem,
+		StatusUncommitted:   styles.StatusUncommitted,
+		StatusUnpushed:      styles.StatusUnpushed,
+		StatusBehind:        styles.StatusBehind,
+		StatusStale:         styles.StatusStale,
+		StatusStash:         styles.StatusStash,
+		StatusMerging:       styles.StatusMerging,
+		StatusRebasing:      styles.StatusRebasing,
+		StatusCherryPicking: styles.StatusCherryPicking,
+		StatusReverting:     styles.StatusReverting,
+		StatusBisecting:     styles.StatusBisecting,
+		StatusUntracked:     styles.StatusUntracked,
+		StatusError:         styles.StatusError,
+		StatusClean:         styles.StatusClean,
+		StatusNotAdded:      styles.StatusNotAdded,
+		Help:                styles.Help,
+		Branch:              styles.Branch,
+		Border:              styles.Border,
+		IconRegular:         styles.IconRegular,
+		IconBare:            styles.IconBare,
+		IconWorktree:        styles.IconWorktree,
 	}
 	return &ListViewRenderer{
 		homeRenderer: home.NewRenderer(homeStyles, themeConfig),
@@ -44,8 +52,8 @@ func (r *ListViewRenderer) Render(repositories []*repomanager.RepoItem, summaryD
 }
 
 // RenderNavigable renders the navigable items list with the given cursor position and dimensions.
-func (r *ListViewRenderer) RenderNavigable(items []types.NavigableItem, summaryData *repomanager.SummaryData, cursor int, width, height int, actions []config.Action, configTitle string) string {
-	return r.homeRenderer.RenderNavigableList(items, *summaryData, cursor, width, height, actions, configTitle)
+func (r *ListViewRenderer) RenderNavigable(items []types.NavigableItem, summaryData *repomanager.SummaryData, cursor int, width, height int, actions []config.Action, configTitle, refreshStatus string, selection home.Selection) string {
+	return r.homeRenderer.RenderNavigableList(items, *summaryData, cursor, width, height, actions, configTitle, refreshStatus, selection)
 }
 
 // ActionConfigRenderer renders the action configuration view.
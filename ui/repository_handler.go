@@ -36,7 +36,7 @@ func (h *RepositoryOperationHandler) DeleteSelectedRepository(m Model) (Model, t
 	navigationHandler := NewNavigationHandler()
 	m.Cursor = navigationHandler.AdjustCursorAfterDeletion(m)
 
-	return m, m.updateRepositoryStatuses()
+	return m.requestRefresh()
 }
 
 // AddRepository adds a new repository from the given path.
@@ -13,7 +13,7 @@ func (m Model) addRepository() (Model, tea.Cmd) {
 		m.Dependencies.GetRepoManager().AddRepo(path)
 		m.State = ListView
 		m.NavItemsNeedSync = true
-		return m, m.updateRepositoryStatuses()
+		return m.requestRefresh()
 	}
 	return m, nil
 }
@@ -24,23 +24,6 @@ func (m Model) removeRepositoryByPath(path string) {
 	m.NavItemsNeedSync = true
 }
 
-// discoverWorktrees discovers and adds worktrees from the currently selected repository.
-func (m Model) discoverWorktrees() (Model, tea.Cmd) {
-	navigableItems := m.getNavigableItems()
-	if m.Cursor >= len(navigableItems) {
-		return m, nil
-	}
-
-	selectedItem := navigableItems[m.Cursor]
-	if selectedItem.Type != "repository" || !selectedItem.Repository.IsBare {
-		return m, nil
-	}
-
-	m.Dependencies.GetRepoManager().ReloadWorktrees()
-	m.NavItemsNeedSync = true
-	return m, m.updateRepositoryStatuses()
-}
-
 // deleteSelectedRepository removes the currently selected repository.
 func (m Model) deleteSelectedRepository() (Model, tea.Cmd) {
 	navigableItems := m.getNavigableItems()
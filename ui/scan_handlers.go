@@ -0,0 +1,261 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/scan"
+)
+
+// ScanComplete carries the repositories found by a scan.
+type ScanComplete struct {
+	Results []repomanager.ScanResult
+	Err     error
+}
+
+// ScanAdded indicates that the checked scan results were added to the list.
+type ScanAdded struct {
+	Paths  []string // Paths added without error
+	Failed []error  // Errors of the paths that could not be added
+}
+
+// openScan switches to the scan page with the directories to scan ready to edit,
+// prefilled with the configured roots or the home directory.
+func (m Model) openScan() Model {
+	roots := m.Config.Scan.Roots
+	if len(roots) == 0 {
+		if home, err := os.UserHomeDir(); err == nil {
+			roots = []string{home}
+		}
+	}
+
+	m.State = ScanView
+	m.ScanRoots = strings.Join(roots, ", ")
+	m.ScanEditing = true
+	m.ScanResults = nil
+	m.ScanChecked = nil
+	m.ScanCursor = 0
+	m.ScanOffset = 0
+	m.ScanScanned = false
+	m.ScanStatus = ""
+	return m
+}
+
+// editScanRoots applies a key press to the directories being typed.
+func (m Model) editScanRoots(msg tea.KeyMsg) Model {
+	if msg.Alt {
+		return m
+	}
+
+	switch msg.Type {
+	case tea.KeyRunes:
+		m.ScanRoots += string(msg.Runes)
+	case tea.KeySpace:
+		m.ScanRoots += " "
+	case tea.KeyBackspace:
+		if runes := []rune(m.ScanRoots); len(runes) > 0 {
+			m.ScanRoots = string(runes[:len(runes)-1])
+		}
+	}
+	return m
+}
+
+// cancelScanEdit stops typing the directories, or leaves the page when nothing was scanned yet.
+func (m Model) cancelScanEdit() Model {
+	if !m.ScanScanned {
+		return m.closeScan()
+	}
+	m.ScanEditing = false
+	return m
+}
+
+// scanRoots returns the comma separated directories typed on the scan page.
+func (m Model) scanRoots() []string {
+	var roots []string
+	for _, root := range strings.Split(m.ScanRoots, ",") {
+		if root = strings.TrimSpace(root); root != "" {
+			roots = append(roots, root)
+		}
+	}
+	return roots
+}
+
+// startScan searches the typed directories in the background, using the configured
+// depth, exclusions and skip settings.
+func (m Model) startScan() (Model, tea.Cmd) {
+	if m.ScanRunning || m.ScanAdding {
+		return m, nil
+	}
+	roots := m.scanRoots()
+	if len(roots) == 0 {
+		m.ScanStatus = "Enter a directory to scan"
+		return m, nil
+	}
+
+	cfg := m.Config.Scan
+	cfg.Roots = roots
+	repoManager := m.Dependencies.GetRepoManager()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m.ScanEditing = false
+	m.ScanRunning = true
+	m.ScanStatus = ""
+	m.ScanCancel = cancel
+	return m, func() tea.Msg {
+		results, err := repoManager.Scan(ctx, cfg)
+		return ScanComplete{Results: results, Err: err}
+	}
+}
+
+// handleScanComplete stores the results. Repositories and bare repositories not
+// in the list yet start checked; worktrees and submodules are usually reached
+// through their repository, so they need to be checked by hand.
+func (m Model) handleScanComplete(msg ScanComplete) (tea.Model, tea.Cmd) {
+	if m.ScanCancel != nil {
+		m.ScanCancel()
+	}
+	m.ScanRunning = false
+	m.ScanCancel = nil
+	if m.State != ScanView {
+		return m, nil
+	}
+
+	switch {
+	case errors.Is(msg.Err, context.Canceled):
+		m.ScanStatus = "Scan cancelled"
+		return m, nil
+	case msg.Err != nil:
+		logging.Get().Error("repository scan failed", "roots", m.ScanRoots, "error", msg.Err)
+		m.ScanStatus = "Scan failed: " + msg.Err.Error()
+		return m, nil
+	}
+
+	m.ScanScanned = true
+	m.ScanResults = msg.Results
+	m.ScanChecked = make([]bool, len(msg.Results))
+	for i, result := range msg.Results {
+		m.ScanChecked[i] = !result.Tracked && (result.Kind == repomanager.ScanRepository || result.Kind == repomanager.ScanBare)
+	}
+	m.ScanCursor = 0
+	m.ScanOffset = 0
+	return m, nil
+}
+
+// cancelScan stops the running scan; its completion is still reported.
+func (m Model) cancelScan() Model {
+	if m.ScanRunning && m.ScanCancel != nil {
+		m.ScanCancel()
+	}
+	return m
+}
+
+// closeScan stops a running scan and returns to the list.
+func (m Model) closeScan() Model {
+	m = m.cancelScan()
+	m.ScanEditing = false
+	m.State = ListView
+	return m
+}
+
+// moveScanCursor moves the selection by delta results.
+func (m Model) moveScanCursor(delta int) Model {
+	m.ScanCursor, m.ScanOffset = scrollWindow(m.ScanCursor, m.ScanOffset, delta, len(m.ScanResults), scan.VisibleRows(m.Height))
+	return m
+}
+
+// toggleScanCheck checks or unchecks the selected result. Results already in the
+// list cannot be checked.
+func (m Model) toggleScanCheck() Model {
+	if m.ScanCursor >= len(m.ScanChecked) || m.ScanResults[m.ScanCursor].Tracked {
+		return m
+	}
+
+	checked := make([]bool, len(m.ScanChecked))
+	copy(checked, m.ScanChecked)
+	checked[m.ScanCursor] = !checked[m.ScanCursor]
+	m.ScanChecked = checked
+	return m
+}
+
+// checkAllScan checks every result not in the list yet, or unchecks all results.
+func (m Model) checkAllScan(check bool) Model {
+	checked := make([]bool, len(m.ScanResults))
+	for i, result := range m.ScanResults {
+		checked[i] = check && !result.Tracked
+	}
+	m.ScanChecked = checked
+	return m
+}
+
+// checkedScanPaths returns the paths of the results checked for adding.
+func (m Model) checkedScanPaths() []string {
+	var paths []string
+	for i, result := range m.ScanResults {
+		if i < len(m.ScanChecked) && m.ScanChecked[i] {
+			paths = append(paths, result.Path)
+		}
+	}
+	return paths
+}
+
+// addScanResults adds the checked results to the list in the background.
+func (m Model) addScanResults() (Model, tea.Cmd) {
+	if m.ScanRunning || m.ScanAdding {
+		return m, nil
+	}
+	paths := m.checkedScanPaths()
+	if len(paths) == 0 {
+		m.ScanStatus = "No repositories checked"
+		return m, nil
+	}
+
+	repoManager := m.Dependencies.GetRepoManager()
+	m.ScanAdding = true
+	m.ScanStatus = ""
+	return m, func() tea.Msg {
+		var msg ScanAdded
+		for _, path := range paths {
+			if err := repoManager.AddRepo(path); err != nil {
+				msg.Failed = append(msg.Failed, fmt.Errorf("%s: %w", path, err))
+				continue
+			}
+			msg.Paths = append(msg.Paths, path)
+		}
+		return msg
+	}
+}
+
+// handleScanAdded marks the added results as in the list and refreshes statuses.
+func (m Model) handleScanAdded(msg ScanAdded) (tea.Model, tea.Cmd) {
+	m.ScanAdding = false
+	m.NavItemsNeedSync = true
+
+	added := make(map[string]bool, len(msg.Paths))
+	for _, path := range msg.Paths {
+		added[path] = true
+	}
+	results := make([]repomanager.ScanResult, len(m.ScanResults))
+	checked := make([]bool, len(m.ScanResults))
+	for i, result := range m.ScanResults {
+		result.Tracked = result.Tracked || added[result.Path]
+		results[i] = result
+		checked[i] = i < len(m.ScanChecked) && m.ScanChecked[i] && !result.Tracked
+	}
+	m.ScanResults = results
+	m.ScanChecked = checked
+
+	m.ScanStatus = fmt.Sprintf("Added %d repositories", len(msg.Paths))
+	if len(msg.Failed) > 0 {
+		for _, err := range msg.Failed {
+			logging.Get().Error("adding scanned repository failed", "error", err)
+		}
+		m.ScanStatus = fmt.Sprintf("Added %d repositories, %d failed: %v", len(msg.Paths), len(msg.Failed), msg.Failed[0])
+	}
+	return m.requestRefresh()
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+)
+
+// newScanModel returns a model showing the results of a finished scan.
+func newScanModel() Model {
+	m := newRefreshModel(0)
+	m.Config.Scan = config.ScanConfig{Roots: []string{"/code", "/work"}}
+	m = m.openScan()
+	m.ScanRunning = true
+	results := []repomanager.ScanResult{
+		{Path: "/code/app", Kind: repomanager.ScanRepository},
+		{Path: "/code/app.git", Kind: repomanager.ScanBare},
+		{Path: "/code/app-feature", Kind: repomanager.ScanWorktree},
+		{Path: "/code/app/lib", Kind: repomanager.ScanSubmodule},
+		{Path: "/work/tool", Kind: repomanager.ScanRepository, Tracked: true},
+	}
+	updated, _ := m.handleScanComplete(ScanComplete{Results: results})
+	return updated.(Model)
+}
+
+func TestEditScanRoots(t *testing.T) {
+	m := newScanModel().openScan()
+	if !m.ScanEditing || m.ScanRoots != "/code, /work" {
+		t.Fatalf("expected the configured roots to be editable, got %q", m.ScanRoots)
+	}
+
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeyBackspace},
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("~/my code")},
+	} {
+		m = m.editScanRoots(key)
+	}
+	if roots := m.scanRoots(); len(roots) != 2 || roots[0] != "/code" || roots[1] != "~/my code" {
+		t.Errorf("roots = %q", roots)
+	}
+
+	m.ScanRoots = " , "
+	if got, cmd := m.startScan(); cmd != nil || got.ScanStatus != "Enter a directory to scan" {
+		t.Errorf("expected a scan without directories to be refused, got status %q", got.ScanStatus)
+	}
+
+	// Leaving the input before anything was scanned leaves the page
+	if got := m.cancelScanEdit(); got.State != ListView {
+		t.Errorf("state = %v, want the list", got.State)
+	}
+}
+
+func TestHandleScanComplete(t *testing.T) {
+	m := newScanModel()
+
+	if m.ScanRunning || !m.ScanScanned || len(m.ScanResults) != 5 {
+		t.Fatalf("results not stored: %+v", m.ScanResults)
+	}
+	want := []bool{true, true, false, false, false}
+	for i, checked := range m.ScanChecked {
+		if checked != want[i] {
+			t.Errorf("checked = %v, want %v", m.ScanChecked, want)
+			break
+		}
+	}
+
+	// Results already in the list stay unchecked
+	m = m.moveScanCursor(4).toggleScanCheck().checkAllScan(true)
+	if m.ScanChecked[4] {
+		t.Error("expected a tracked result not to be checked")
+	}
+	if paths := m.checkedScanPaths(); len(paths) != 4 {
+		t.Errorf("checked paths = %q", paths)
+	}
+
+	updated, _ := m.handleScanComplete(ScanComplete{Err: context.Canceled})
+	if got := updated.(Model); got.ScanStatus != "Scan cancelled" || len(got.ScanResults) != 5 {
+		t.Errorf("expected a cancelled scan to keep the results, got status %q", got.ScanStatus)
+	}
+}
+
+func TestHandleScanAdded(t *testing.T) {
+	m := newScanModel().checkAllScan(false)
+	if got, cmd := m.addScanResults(); cmd != nil || got.ScanStatus != "No repositories checked" {
+		t.Errorf("expected nothing to add, got status %q", got.ScanStatus)
+	}
+
+	m = m.checkAllScan(true)
+	m.ScanAdding = true
+	updated, cmd := m.handleScanAdded(ScanAdded{
+		Paths:  []string{"/code/app", "/code/app.git", "/code/app-feature"},
+		Failed: []error{errors.New("/code/app/lib: permission denied")},
+	})
+	got := updated.(Model)
+	if got.ScanAdding || !got.NavItemsNeedSync || cmd == nil {
+		t.Error("expected the list to be synced and refreshed")
+	}
+	if !got.ScanResults[0].Tracked || got.ScanResults[3].Tracked {
+		t.Errorf("expected only added results to be marked, got %+v", got.ScanResults)
+	}
+	if paths := got.checkedScanPaths(); len(paths) != 1 || paths[0] != "/code/app/lib" {
+		t.Errorf("expected the failed result to stay checked, got %q", paths)
+	}
+	if got.ScanStatus != "Added 3 repositories, 1 failed: /code/app/lib: permission denied" {
+		t.Errorf("status = %q", got.ScanStatus)
+	}
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// StashesLoaded carries the stash list of the repository shown in the details view.
+type StashesLoaded struct {
+	Path    string
+	Entries []repomanager.StashEntry
+	Err     error
+}
+
+// StashActionComplete indicates that applying, popping or dropping a stash finished.
+type StashActionComplete struct {
+	Path   string
+	Action string // "apply", "pop" or "drop"
+	Entry  repomanager.StashEntry
+	Err    error
+}
+
+// openDetails switches to the details view of an item and loads its changed files and stash list.
+func (m Model) openDetails(item types.NavigableItem) (Model, tea.Cmd) {
+	m.State = DetailsView
+	m.SelectedNavItem = &item
+	m.Files = nil
+	m.FileCursor = 0
+	m.FileOffset = 0
+	m.FileStatus = ""
+	m.DetailsFocus = detailsFocusFiles
+	m.Stashes = nil
+	m.StashCursor = 0
+	m.StashStatus = ""
+	m.StashDropConfirm = false
+	return m, tea.Batch(m.loadChangedFiles(item), m.loadStashes(item.Path()))
+}
+
+// loadStashes reads the stash list of the repository at path.
+func (m Model) loadStashes(path string) tea.Cmd {
+	repoManager := m.Dependencies.GetRepoManager()
+
+	return func() tea.Msg {
+		entries, err := repoManager.ListStashes(path)
+		return StashesLoaded{Path: path, Entries: entries, Err: err}
+	}
+}
+
+// handleStashesLoaded stores the stash list if the details view still shows the same item.
+func (m Model) handleStashesLoaded(msg StashesLoaded) (tea.Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	if msg.Err != nil {
+		m.StashStatus = "Failed to list stashes: " + msg.Err.Error()
+	}
+	m.Stashes = msg.Entries
+	if m.StashCursor >= len(m.Stashes) {
+		m.StashCursor = max(len(m.Stashes)-1, 0)
+	}
+	return m, nil
+}
+
+// runStashAction applies, pops or drops the selected stash in the background.
+func (m Model) runStashAction(action string) (Model, tea.Cmd) {
+	if m.SelectedNavItem == nil || m.StashCursor >= len(m.Stashes) {
+		return m, nil
+	}
+
+	path := m.SelectedNavItem.Path()
+	entry := m.Stashes[m.StashCursor]
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.StashStatus = fmt.Sprintf("Running stash %s on %s...", action, entry.Ref)
+	return m, func() tea.Msg {
+		var err error
+		switch action {
+		case "apply":
+			err = repoManager.ApplyStash(path, entry)
+		case "pop":
+			err = repoManager.PopStash(path, entry)
+		case "drop":
+			err = repoManager.DropStash(path, entry)
+		}
+		return StashActionComplete{Path: path, Action: action, Entry: entry, Err: err}
+	}
+}
+
+// confirmStashDrop asks for confirmation before dropping the selected stash, since
+// a dropped stash cannot be restored from here.
+func (m Model) confirmStashDrop() Model {
+	if m.StashCursor >= len(m.Stashes) {
+		return m
+	}
+
+	m.StashDropConfirm = true
+	m.StashStatus = fmt.Sprintf("Drop %s? Press y to confirm, any other key to cancel", m.Stashes[m.StashCursor].Ref)
+	return m
+}
+
+// handleStashAction reports the outcome of a stash action, reloads the stash list
+// and refreshes statuses since the working tree may have changed.
+func (m Model) handleStashAction(msg StashActionComplete) (tea.Model, tea.Cmd) {
+	if m.SelectedNavItem != nil && m.SelectedNavItem.Path() == msg.Path {
+		if msg.Err != nil {
+			m.StashStatus = fmt.Sprintf("Stash %s failed: %v", msg.Action, msg.Err)
+		} else {
+			m.StashStatus = fmt.Sprintf("Stash %s of %s succeeded", msg.Action, msg.Entry.Ref)
+		}
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, tea.Batch(m.loadStashes(msg.Path), refresh)
+}
@@ -1,28 +1,77 @@
 package ui
 
 import (
+	"time"
+
 	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
 )
 
 // StatusUpdateComplete indicates that status updates have finished.
 type StatusUpdateComplete struct{}
 
+// StatusItemUpdated indicates that a single repository or worktree finished refreshing.
+type StatusItemUpdated struct {
+	Update  repomanager.StatusUpdate
+	updates <-chan repomanager.StatusUpdate
+}
+
 // updateRepositoryStatuses initiates an asynchronous update of all repository statuses.
+// Each refreshed item is reported with a StatusItemUpdated message, followed by a
+// final StatusUpdateComplete once every item is done.
 func (m Model) updateRepositoryStatuses() tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
-		// Use repo manager to update all statuses
-		m.Dependencies.GetRepoManager().ReloadStatus()
+	repoManager := m.Dependencies.GetRepoManager()
 
-		// Return a simple message indicating status update is complete
-		return StatusUpdateComplete{}
-	})
+	return func() tea.Msg {
+		updates := make(chan repomanager.StatusUpdate)
+		go func() {
+			defer close(updates)
+			repoManager.ReloadStatusWithProgress(func(update repomanager.StatusUpdate) {
+				updates <- update
+			})
+		}()
+
+		return waitForStatusUpdate(updates)()
+	}
+}
+
+// waitForStatusUpdate waits for the next per-item update on the given channel.
+func waitForStatusUpdate(updates <-chan repomanager.StatusUpdate) tea.Cmd {
+	return func() tea.Msg {
+		update, ok := <-updates
+		if !ok {
+			return StatusUpdateComplete{}
+		}
+		return StatusItemUpdated{Update: update, updates: updates}
+	}
+}
+
+// handleStatusItemUpdate processes a single finished item and waits for the next one.
+func (m Model) handleStatusItemUpdate(msg StatusItemUpdated) (tea.Model, tea.Cmd) {
+	// Items are snapshots of the repo manager's, so rebuild them to show the refreshed row
+	m = m.syncItems()
+	return m, waitForStatusUpdate(msg.updates)
 }
 
 // handleStatusUpdate processes repository status updates and updates the model.
+// A refresh requested while this one was running is started now, and the changed
+// files of an open details view are read again.
 func (m Model) handleStatusUpdate(msg StatusUpdateComplete) (tea.Model, tea.Cmd) {
 	// Repository service now handles all status updates internally
-	// Just mark navigable items cache as needing sync
-	m.NavItemsNeedSync = true
+	// Just rebuild the navigable items from the refreshed snapshots
+	m = m.syncItems()
+	m.Refreshing = false
+	m.LastRefresh = time.Now()
+
+	var files tea.Cmd
+	if m.State == DetailsView && m.SelectedNavItem != nil {
+		files = m.loadChangedFiles(*m.SelectedNavItem)
+	}
 
-	return m, nil
+	if m.RefreshQueued {
+		m.RefreshQueued = false
+		m, refresh := m.requestRefresh()
+		return m, tea.Batch(files, refresh)
+	}
+	return m, files
 }
@@ -9,6 +9,40 @@ type NavigableItem struct {
 	Type         string // "repository" or "worktree"
 	Repository   *repomanager.RepoItem
 	WorktreeInfo *repomanager.SubItem
-	ParentRepo   *repomanager.RepoItem // For worktrees, reference to parent bare repo
+	ParentRepo   *repomanager.RepoItem // For worktrees, reference to the repository they belong to
 	IsLast       bool                  // For worktrees, indicates if this is the last worktree for the parent repo
 }
+
+// Path returns the filesystem path of the repository or worktree.
+func (n NavigableItem) Path() string {
+	if n.Type == "worktree" && n.WorktreeInfo != nil {
+		return n.WorktreeInfo.Path
+	}
+	if n.Repository != nil {
+		return n.Repository.Path
+	}
+	return ""
+}
+
+// Name returns the display name of the repository or worktree.
+func (n NavigableItem) Name() string {
+	if n.Type == "worktree" && n.WorktreeInfo != nil {
+		return n.WorktreeInfo.Name
+	}
+	if n.Repository != nil {
+		return n.Repository.Name
+	}
+	return ""
+}
+
+// Branch returns the checked out branch of the repository or worktree, empty when
+// HEAD is detached.
+func (n NavigableItem) Branch() string {
+	if n.Type == "worktree" && n.WorktreeInfo != nil {
+		return n.WorktreeInfo.Branch
+	}
+	if n.Repository != nil {
+		return n.Repository.Branch
+	}
+	return ""
+}
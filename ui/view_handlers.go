@@ -1,14 +1,27 @@
 package ui
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jarmocluyse/git-dash/internal/theme"
+	"github.com/jarmocluyse/git-dash/ui/pages/branches"
+	"github.com/jarmocluyse/git-dash/ui/pages/bulk"
+	"github.com/jarmocluyse/git-dash/ui/pages/cleanup"
+	"github.com/jarmocluyse/git-dash/ui/pages/commit"
+	"github.com/jarmocluyse/git-dash/ui/pages/commitlog"
 	"github.com/jarmocluyse/git-dash/ui/pages/details"
+	"github.com/jarmocluyse/git-dash/ui/pages/diff"
+	"github.com/jarmocluyse/git-dash/ui/pages/home"
+	"github.com/jarmocluyse/git-dash/ui/pages/remote"
+	"github.com/jarmocluyse/git-dash/ui/pages/scan"
 	"github.com/jarmocluyse/git-dash/ui/pages/settings"
+	"github.com/jarmocluyse/git-dash/ui/pages/worktree"
+	"github.com/jarmocluyse/git-dash/ui/pages/worktrees"
 	"github.com/jarmocluyse/git-dash/ui/types"
 )
 
@@ -31,6 +44,26 @@ func (m Model) View() string {
 		mainView = m.renderDetailsView()
 	case ActionConfigView:
 		mainView = m.renderActionConfigView()
+	case LogView:
+		mainView = m.renderLogView()
+	case DiffView:
+		mainView = m.renderDiffView()
+	case CommitView:
+		mainView = m.renderCommitView()
+	case RemoteView:
+		mainView = m.renderRemoteView()
+	case BulkView:
+		mainView = m.renderBulkView()
+	case BranchView:
+		mainView = m.renderBranchView()
+	case CleanupView:
+		mainView = m.renderCleanupView()
+	case WorktreeView:
+		mainView = m.renderWorktreeView()
+	case WorktreeListView:
+		mainView = m.renderWorktreeListView()
+	case ScanView:
+		mainView = m.renderScanView()
 	default:
 		mainView = ""
 	}
@@ -80,7 +113,14 @@ func (m Model) renderListView() string {
 	summaryData := m.Dependencies.GetRepoManager().GetSummary()
 	configTitle := m.Config.Title
 
-	return renderer.RenderNavigable(visibleItems, &summaryData, relativeCursor, m.Width, m.Height, m.Config.Keybindings.Actions, configTitle)
+	// In select mode the header counts the marked items instead of showing the refresh status
+	status := m.refreshStatusText()
+	if m.SelectMode {
+		status = fmt.Sprintf("%d marked", len(m.Marked))
+	}
+	selection := home.Selection{Active: m.SelectMode, Marked: m.Marked}
+
+	return renderer.RenderNavigable(visibleItems, &summaryData, relativeCursor, m.Width, m.Height, m.Config.Keybindings.Actions, configTitle, status, selection)
 }
 
 // renderSettingsView renders the settings view.
@@ -121,6 +161,10 @@ func NewDetailsViewRenderer(styles StyleConfig, themeConfig theme.Theme) *detail
 		Help:         styles.Help,
 		Border:       styles.Border,
 		Title:        styles.Item.Bold(true),
+		Conflicted:   styles.StatusError,
+		Staged:       styles.StatusClean,
+		Unstaged:     styles.StatusUncommitted,
+		Untracked:    styles.StatusUntracked,
 	}
 	return details.NewRenderer(detailsStyles, themeConfig)
 }
@@ -134,7 +178,395 @@ func (m Model) renderDetailsView() string {
 
 	styles := CreateStyleConfig(m.Config.Theme)
 	renderer := NewDetailsViewRenderer(styles, m.Config.Theme)
-	return renderer.Render(*m.SelectedNavItem, m.Width, m.Height)
+	files := details.FileSection{
+		Files:     m.Files,
+		Cursor:    m.FileCursor,
+		Offset:    m.FileOffset,
+		Focused:   m.DetailsFocus != detailsFocusStashes,
+		Status:    m.FileStatus,
+		EditorKey: m.Config.Keybindings.EditorAction().Key,
+	}
+	stashes := details.StashSection{
+		Entries: m.Stashes,
+		Cursor:  m.StashCursor,
+		Focused: m.DetailsFocus == detailsFocusStashes,
+		Status:  m.StashStatus,
+	}
+	return renderer.Render(*m.SelectedNavItem, files, stashes, m.Width, m.Height)
+}
+
+// NewLogViewRenderer creates a new commit log renderer with the given styles and theme.
+func NewLogViewRenderer(styles StyleConfig, themeConfig theme.Theme) *commitlog.Renderer {
+	logStyles := commitlog.StyleConfig{
+		Item:     styles.Item,
+		Selected: styles.SelectedItem,
+		Graph:    styles.Branch.Bold(false),
+		Hash:     styles.StatusBehind.Bold(false),
+		Date:     styles.Help.Margin(0),
+		Author:   styles.IconRegular.Bold(false),
+		Unpushed: styles.StatusUnpushed,
+		Added:    styles.StatusClean,
+		Deleted:  styles.StatusError,
+		Label:    styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)).Bold(true),
+		Help:     styles.Help,
+	}
+	return commitlog.NewRenderer(logStyles, themeConfig)
+}
+
+// renderLogView renders the commit log, or a single commit when one is opened.
+func (m Model) renderLogView() string {
+	if m.SelectedNavItem == nil {
+		return m.renderListView()
+	}
+
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewLogViewRenderer(styles, m.Config.Theme)
+	name := m.SelectedNavItem.Name()
+
+	if m.LogDetail != nil {
+		return renderer.RenderCommit(name, *m.LogDetail, m.LogDetailOffset, m.Width, m.Height)
+	}
+
+	data := commitlog.LogData{
+		Name:    name,
+		Entries: m.LogEntries,
+		Cursor:  m.LogCursor,
+		Offset:  m.LogOffset,
+		HasMore: m.LogHasMore,
+		Loading: m.LogLoading,
+		Status:  m.LogStatus,
+	}
+	return renderer.RenderLog(data, m.Width, m.Height)
+}
+
+// NewDiffViewRenderer creates a new diff view renderer with the given styles and theme.
+func NewDiffViewRenderer(styles StyleConfig, themeConfig theme.Theme) *diff.Renderer {
+	diffStyles := diff.StyleConfig{
+		Context: styles.Item,
+		Added:   styles.DiffAdded,
+		Removed: styles.DiffRemoved,
+		Hunk:    styles.DiffHunk,
+		Header:  styles.DiffHeader,
+		Help:    styles.Help,
+	}
+	return diff.NewRenderer(diffStyles, themeConfig)
+}
+
+// renderDiffView renders the diff of the selected file or working tree.
+func (m Model) renderDiffView() string {
+	if m.SelectedNavItem == nil {
+		return m.renderListView()
+	}
+
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewDiffViewRenderer(styles, m.Config.Theme)
+	data := diff.DiffData{
+		Name:    m.SelectedNavItem.Name(),
+		File:    m.DiffOptions.File,
+		Staged:  m.DiffOptions.Staged,
+		Diff:    m.Diff,
+		Offset:  m.DiffOffset,
+		Column:  m.DiffColumn,
+		Wrap:    m.DiffWrap,
+		Loading: m.DiffLoading,
+		Status:  m.DiffStatus,
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewCommitViewRenderer creates a new commit composer renderer with the given styles and theme.
+func NewCommitViewRenderer(styles StyleConfig, themeConfig theme.Theme) *commit.Renderer {
+	commitStyles := commit.StyleConfig{
+		Item:   styles.Item,
+		Title:  styles.Item.Bold(true),
+		Value:  styles.Item,
+		Editor: styles.Border,
+		Staged: styles.StatusClean,
+		Error:  styles.StatusError,
+		Help:   styles.Help,
+	}
+	return commit.NewRenderer(commitStyles, themeConfig)
+}
+
+// renderCommitView renders the commit composer of the selected item.
+func (m Model) renderCommitView() string {
+	if m.SelectedNavItem == nil {
+		return m.renderListView()
+	}
+
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewCommitViewRenderer(styles, m.Config.Theme)
+	data := commit.CommitData{
+		Name:    m.SelectedNavItem.Name(),
+		Branch:  m.SelectedNavItem.Branch(),
+		Message: m.CommitMessage,
+		Amend:   m.CommitAmend,
+		SignOff: m.CommitSignOff,
+		Files:   m.Files,
+		Running: m.CommitRunning,
+		Status:  m.CommitStatus,
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewRemoteViewRenderer creates a new push and pull renderer with the given styles and theme.
+func NewRemoteViewRenderer(styles StyleConfig, themeConfig theme.Theme) *remote.Renderer {
+	remoteStyles := remote.StyleConfig{
+		Output:  styles.Item,
+		Success: styles.StatusClean,
+		Failure: styles.StatusError,
+		Running: styles.Help.Margin(0),
+		Help:    styles.Help,
+	}
+	return remote.NewRenderer(remoteStyles, themeConfig)
+}
+
+// renderRemoteView renders the output of the running or last push or pull.
+func (m Model) renderRemoteView() string {
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewRemoteViewRenderer(styles, m.Config.Theme)
+	data := remote.RemoteData{
+		Name:    m.RemoteName,
+		Action:  m.RemoteAction,
+		Output:  m.RemoteOutput,
+		Running: m.RemoteRunning,
+		Failed:  m.RemoteFailed,
+		Status:  m.RemoteStatus,
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewBranchViewRenderer creates a new branch page renderer with the given styles and theme.
+func NewBranchViewRenderer(styles StyleConfig, themeConfig theme.Theme) *branches.Renderer {
+	branchStyles := branches.StyleConfig{
+		Item:     styles.Item,
+		Selected: styles.SelectedItem,
+		Remote:   styles.StatusNotAdded,
+		Head:     styles.Branch,
+		Worktree: styles.IconWorktree,
+		Date:     styles.Help.Margin(0),
+		Ahead:    styles.StatusUnpushed,
+		Behind:   styles.StatusBehind,
+		Muted:    styles.StatusNotAdded,
+		Input:    styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)),
+		Error:    styles.StatusError,
+		Help:     styles.Help,
+	}
+	return branches.NewRenderer(branchStyles, themeConfig)
+}
+
+// renderBranchView renders the branches of the selected item.
+func (m Model) renderBranchView() string {
+	if m.SelectedNavItem == nil {
+		return m.renderListView()
+	}
+
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewBranchViewRenderer(styles, m.Config.Theme)
+	data := branches.BranchData{
+		Name:     m.SelectedNavItem.Name(),
+		Branches: m.Branches,
+		Cursor:   m.BranchCursor,
+		Offset:   m.BranchOffset,
+		Loading:  m.BranchLoading,
+		Input:    m.BranchInput,
+		Status:   m.BranchStatus,
+	}
+	if branch, ok := m.selectedBranch(); ok {
+		switch m.BranchInputAction {
+		case branchInputCreate:
+			data.InputPrompt = "New branch from " + branch.Name
+		case branchInputRename:
+			data.InputPrompt = "Rename " + branch.Name + " to"
+		}
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewWorktreeViewRenderer creates a new worktree dialog renderer with the given styles and theme.
+func NewWorktreeViewRenderer(styles StyleConfig, themeConfig theme.Theme) *worktree.Renderer {
+	worktreeStyles := worktree.StyleConfig{
+		Item:     styles.Item,
+		Selected: styles.SelectedItem,
+		Remote:   styles.StatusNotAdded,
+		Muted:    styles.StatusNotAdded,
+		Input:    styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)),
+		Error:    styles.StatusError,
+		Help:     styles.Help,
+	}
+	return worktree.NewRenderer(worktreeStyles, themeConfig)
+}
+
+// renderWorktreeView renders the dialog creating a worktree for the selected repository.
+func (m Model) renderWorktreeView() string {
+	if m.SelectedNavItem == nil {
+		return m.renderListView()
+	}
+
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewWorktreeViewRenderer(styles, m.Config.Theme)
+	data := worktree.WorktreeData{
+		Name:     m.SelectedNavItem.Name(),
+		Branches: m.WorktreeBranches,
+		Cursor:   m.WorktreeCursor,
+		Offset:   m.WorktreeOffset,
+		Loading:  m.WorktreeLoading,
+		Input:    m.WorktreeInput,
+		Status:   m.WorktreeStatus,
+	}
+	if wt, ok := m.newWorktree(); ok && m.WorktreeInputAction != "" {
+		switch {
+		case m.WorktreeInputAction == worktreeInputBranch:
+			data.InputPrompt = "New branch from " + wt.Branch.Name
+		case wt.NewBranch != "":
+			data.InputPrompt = fmt.Sprintf("Path for new branch %s from %s", wt.NewBranch, wt.Branch.Name)
+		case wt.Branch.Remote:
+			data.InputPrompt = fmt.Sprintf("Path for %s tracking %s", wt.LocalBranch(), wt.Branch.Name)
+		default:
+			data.InputPrompt = "Path for " + wt.Branch.Name
+		}
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewWorktreeListViewRenderer creates a new worktree list renderer with the given styles and theme.
+func NewWorktreeListViewRenderer(styles StyleConfig, themeConfig theme.Theme) *worktrees.Renderer {
+	worktreeStyles := worktrees.StyleConfig{
+		Item:     styles.Item,
+		Selected: styles.SelectedItem,
+		Icon:     styles.IconWorktree,
+		Branch:   styles.Branch,
+		Dirty:    styles.StatusUncommitted,
+		State:    styles.StatusStale,
+		Input:    styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)),
+		Error:    styles.StatusError,
+		Help:     styles.Help,
+	}
+	return worktrees.NewRenderer(worktreeStyles, themeConfig)
+}
+
+// renderWorktreeListView renders the linked worktrees of the selected repository.
+func (m Model) renderWorktreeListView() string {
+	if m.WorktreeRepo == nil {
+		return m.renderListView()
+	}
+
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewWorktreeListViewRenderer(styles, m.Config.Theme)
+	data := worktrees.WorktreesData{
+		Name:      m.WorktreeRepo.Name,
+		Worktrees: m.worktreeListItems(),
+		Cursor:    m.WorktreeListCursor,
+		Offset:    m.WorktreeListOffset,
+		Input:     m.WorktreeLockInput,
+		Status:    m.WorktreeListStatus,
+	}
+	if worktree, ok := m.selectedWorktree(); ok && m.WorktreeLocking {
+		data.InputPrompt = "Reason for locking " + worktree.Name + " (optional)"
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewCleanupViewRenderer creates a new branch cleanup renderer with the given styles and theme.
+func NewCleanupViewRenderer(styles StyleConfig, themeConfig theme.Theme) *cleanup.Renderer {
+	cleanupStyles := cleanup.StyleConfig{
+		Item:     styles.Item,
+		Selected: styles.SelectedItem,
+		Marked:   styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)),
+		Repo:     styles.IconRegular.Bold(false),
+		Reason:   styles.Help.Margin(0),
+		Warning:  styles.StatusError,
+		Help:     styles.Help,
+	}
+	return cleanup.NewRenderer(cleanupStyles, themeConfig)
+}
+
+// renderCleanupView renders the branch cleanup report or its confirmation screen.
+func (m Model) renderCleanupView() string {
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewCleanupViewRenderer(styles, m.Config.Theme)
+	data := cleanup.CleanupData{
+		Branches:  m.CleanupBranches,
+		Checked:   m.CleanupChecked,
+		Cursor:    m.CleanupCursor,
+		Offset:    m.CleanupOffset,
+		Loading:   m.CleanupLoading,
+		StaleDays: m.CleanupStaleDays,
+		Confirm:   m.CleanupConfirm,
+		Status:    m.CleanupStatus,
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewScanViewRenderer creates a new repository scan renderer with the given styles and theme.
+func NewScanViewRenderer(styles StyleConfig, themeConfig theme.Theme) *scan.Renderer {
+	scanStyles := scan.StyleConfig{
+		Item:     styles.Item,
+		Selected: styles.SelectedItem,
+		Marked:   styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)),
+		Kind:     styles.IconRegular.Bold(false),
+		Muted:    styles.StatusNotAdded,
+		Input:    styles.Item.Foreground(lipgloss.Color(themeConfig.Colors.Selected)),
+		Warning:  styles.StatusError,
+		Help:     styles.Help,
+	}
+	return scan.NewRenderer(scanStyles, themeConfig)
+}
+
+// renderScanView renders the directories to scan and the repositories found in them.
+func (m Model) renderScanView() string {
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewScanViewRenderer(styles, m.Config.Theme)
+	data := scan.ScanData{
+		Roots:    m.ScanRoots,
+		Editing:  m.ScanEditing,
+		Results:  m.ScanResults,
+		Checked:  m.ScanChecked,
+		Cursor:   m.ScanCursor,
+		Offset:   m.ScanOffset,
+		Scanning: m.ScanRunning,
+		Adding:   m.ScanAdding,
+		Scanned:  m.ScanScanned,
+		Status:   m.ScanStatus,
+	}
+	return renderer.Render(data, m.Width, m.Height)
+}
+
+// NewBulkViewRenderer creates a new bulk operation renderer with the given styles and theme.
+func NewBulkViewRenderer(styles StyleConfig, themeConfig theme.Theme) *bulk.Renderer {
+	bulkStyles := bulk.StyleConfig{
+		Item:    styles.Item,
+		Success: styles.StatusClean,
+		Failure: styles.StatusError,
+		Pending: styles.Help.Margin(0),
+		Help:    styles.Help,
+	}
+	return bulk.NewRenderer(bulkStyles, themeConfig)
+}
+
+// renderBulkView renders the per-item results of the running or last bulk operation.
+func (m Model) renderBulkView() string {
+	styles := CreateStyleConfig(m.Config.Theme)
+	renderer := NewBulkViewRenderer(styles, m.Config.Theme)
+
+	entries := make([]bulk.Entry, len(m.BulkEntries))
+	for i, entry := range m.BulkEntries {
+		entries[i] = bulk.Entry{Name: entry.Name, Done: entry.Done}
+		switch {
+		case errors.Is(entry.Err, context.Canceled):
+			entries[i].Error = "cancelled"
+		case entry.Err != nil:
+			entries[i].Error = entry.Err.Error()
+		}
+	}
+
+	data := bulk.BulkData{
+		Action:  m.BulkAction,
+		Entries: entries,
+		Running: m.BulkRunning,
+		Offset:  m.BulkOffset,
+	}
+	return renderer.Render(data, m.Width, m.Height)
 }
 
 // renderHelpModal renders the help modal overlay on top of the background view.
@@ -164,10 +596,105 @@ func (m Model) renderHelpModal(backgroundView string) string {
 		helpContent.WriteString("  e             Open in file manager\n")
 		helpContent.WriteString("  s             Settings\n")
 		helpContent.WriteString("  r/F5          Refresh statuses\n")
-		helpContent.WriteString("  w             Discover worktrees\n\n")
+		helpContent.WriteString("  p             Pause/resume auto refresh\n")
+		helpContent.WriteString("  o             Toggle sort by recent commit\n")
+		helpContent.WriteString("  C             Commit staged changes\n")
+		helpContent.WriteString("  B             Branches\n")
+		helpContent.WriteString("  P/U           Push/pull\n")
+		helpContent.WriteString("  v             Select mode for bulk operations\n")
+		helpContent.WriteString("  X             Branch cleanup report\n")
+		helpContent.WriteString("  S             Scan directories for repositories\n")
+		helpContent.WriteString("  w             Create a worktree for the selected repository\n")
+		helpContent.WriteString("  W             Manage the worktrees of the selected repository\n\n")
+		if m.SelectMode {
+			helpContent.WriteString("SELECT MODE:\n")
+			helpContent.WriteString("  Space         Mark/unmark item\n")
+			helpContent.WriteString("  a/n           Mark all/none\n")
+			helpContent.WriteString("  d/u/b         Mark dirty/unpushed/behind\n")
+			helpContent.WriteString("  f             Fetch marked\n")
+			helpContent.WriteString("  P/U           Push/pull marked\n")
+			helpContent.WriteString("  action key    Run the action on marked\n")
+			helpContent.WriteString("  Esc/v         Leave select mode\n\n")
+		}
 	case DetailsView:
 		helpContent.WriteString("DETAILS VIEW:\n")
+		helpContent.WriteString("  Tab           Switch between files and stashes\n")
+		helpContent.WriteString("  ↑/↓           Select file or stash\n")
+		helpContent.WriteString(fmt.Sprintf("  %-13s Open file in editor\n", m.Config.Keybindings.EditorAction().Key))
+		helpContent.WriteString("  a             Apply stash\n")
+		helpContent.WriteString("  p             Pop stash\n")
+		helpContent.WriteString("  d             Drop stash (asks to confirm)\n")
+		helpContent.WriteString("  s/u           Stage/unstage selected file\n")
+		helpContent.WriteString("  x             Discard selected file (asks to confirm)\n")
+		helpContent.WriteString("  v/V           Diff of selected file/whole tree\n")
+		helpContent.WriteString("  C             Commit staged changes\n")
+		helpContent.WriteString("  P/U           Push/pull\n")
+		helpContent.WriteString("  l             Commit log\n")
+		helpContent.WriteString("  B             Branches\n")
+		helpContent.WriteString("  W             Worktrees of the repository\n")
+		helpContent.WriteString("  b/Esc         Back to list\n\n")
+	case DiffView:
+		helpContent.WriteString("DIFF:\n")
+		helpContent.WriteString("  PgUp/PgDn     Page up/down (also Ctrl+U/Ctrl+D)\n")
+		helpContent.WriteString("  n/N           Next/previous hunk (also ]/[)\n")
+		helpContent.WriteString("  Tab           Toggle staged/unstaged\n")
+		helpContent.WriteString("  s/u           Stage/unstage current hunk\n")
+		helpContent.WriteString("  x             Discard current hunk (asks to confirm)\n")
+		helpContent.WriteString("  w             Toggle line wrap\n")
+		helpContent.WriteString("  ←/→           Scroll sideways (h/l)\n")
+		helpContent.WriteString("  b/Esc         Back to details\n\n")
+	case BranchView:
+		helpContent.WriteString("BRANCHES:\n")
+		helpContent.WriteString("  Enter         Check out selected branch\n")
+		helpContent.WriteString("  n             New branch from selected\n")
+		helpContent.WriteString("  r             Rename selected branch\n")
+		helpContent.WriteString("  d             Delete selected branch (asks to confirm)\n")
+		helpContent.WriteString("  b/Esc         Back\n")
+		helpContent.WriteString(fmt.Sprintf("  %s            Checked out in another worktree\n\n", m.Config.Theme.Icons.Repository.Worktree))
+	case WorktreeView:
+		helpContent.WriteString("NEW WORKTREE:\n")
+		helpContent.WriteString("  Enter         Use the selected branch, or confirm the typed name or path\n")
+		helpContent.WriteString("  n             New branch from the selected branch\n")
+		helpContent.WriteString("  Esc           Cancel typing\n")
+		helpContent.WriteString("  b/Esc         Back to list\n\n")
+	case WorktreeListView:
+		helpContent.WriteString("WORKTREES:\n")
+		helpContent.WriteString("  d             Remove worktree (asks to confirm, forces after a warning when dirty)\n")
+		helpContent.WriteString("  p             Prune stale worktree entries\n")
+		helpContent.WriteString("  L             Lock with an optional reason, or unlock\n")
+		helpContent.WriteString("  b/Esc         Back\n\n")
+	case CleanupView:
+		helpContent.WriteString("BRANCH CLEANUP:\n")
+		helpContent.WriteString("  Space         Check/uncheck branch\n")
+		helpContent.WriteString("  a/n           Check all/none\n")
+		helpContent.WriteString("  d             Delete checked branches (asks to confirm)\n")
+		helpContent.WriteString("  r             Read the report again\n")
 		helpContent.WriteString("  b/Esc         Back to list\n\n")
+	case ScanView:
+		helpContent.WriteString("REPOSITORY SCAN:\n")
+		helpContent.WriteString("  Enter         Scan the typed directories, or add checked results\n")
+		helpContent.WriteString("  Space         Check/uncheck result\n")
+		helpContent.WriteString("  a/n           Check all/none\n")
+		helpContent.WriteString("  e             Edit the directories to scan\n")
+		helpContent.WriteString("  r             Scan again\n")
+		helpContent.WriteString("  x             Cancel the running scan\n")
+		helpContent.WriteString("  b/Esc         Back to list\n\n")
+	case BulkView:
+		helpContent.WriteString("BULK OPERATION:\n")
+		helpContent.WriteString("  ↑/↓           Scroll results\n")
+		helpContent.WriteString("  x             Cancel the remaining operations\n")
+		helpContent.WriteString("  b/Esc         Back, the operations keep running\n\n")
+	case RemoteView:
+		helpContent.WriteString("PUSH/PULL:\n")
+		helpContent.WriteString("  x             Cancel the running command\n")
+		helpContent.WriteString("  b/Esc         Back, the command keeps running\n\n")
+	case LogView:
+		helpContent.WriteString("COMMIT LOG:\n")
+		helpContent.WriteString("  PgUp/PgDn     Page up/down (also Ctrl+U/Ctrl+D)\n")
+		helpContent.WriteString("  g/G           First/last loaded commit\n")
+		helpContent.WriteString("  Enter         Show commit message and files\n")
+		helpContent.WriteString("  b/Esc         Back to log or details\n")
+		helpContent.WriteString(fmt.Sprintf("  %s            Not pushed to upstream\n\n", m.Config.Theme.Indicators.Unpushed))
 	case SettingsView:
 		helpContent.WriteString("SETTINGS:\n")
 		helpContent.WriteString("  [/]           Switch tabs\n")
@@ -192,6 +719,18 @@ func (m Model) renderHelpModal(backgroundView string) string {
 	cleanStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusClean))
 	dirtyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusDirty))
 	unpushedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusUnpushed))
+	behindStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusBehind))
+	staleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusStale))
+	stashStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusStash))
+	operationStyles := []struct {
+		color, indicator, description string
+	}{
+		{m.Config.Theme.Colors.StatusMerging, m.Config.Theme.Indicators.Merging, "Merge in progress"},
+		{m.Config.Theme.Colors.StatusRebasing, m.Config.Theme.Indicators.Rebasing, "Rebase in progress"},
+		{m.Config.Theme.Colors.StatusCherryPicking, m.Config.Theme.Indicators.CherryPicking, "Cherry-pick in progress"},
+		{m.Config.Theme.Colors.StatusReverting, m.Config.Theme.Indicators.Reverting, "Revert in progress"},
+		{m.Config.Theme.Colors.StatusBisecting, m.Config.Theme.Indicators.Bisecting, "Bisect in progress"},
+	}
 	untrackedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusUntracked))
 	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusError))
 	notAddedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.Config.Theme.Colors.StatusNotAdded))
@@ -202,6 +741,17 @@ func (m Model) renderHelpModal(backgroundView string) string {
 		dirtyStyle.Render(m.Config.Theme.Indicators.Dirty)))
 	helpContent.WriteString(fmt.Sprintf("  %s            Unpushed commits\n",
 		unpushedStyle.Render(m.Config.Theme.Indicators.Unpushed)))
+	helpContent.WriteString(fmt.Sprintf("  %s            Behind upstream\n",
+		behindStyle.Render(m.Config.Theme.Indicators.Behind)))
+	helpContent.WriteString(fmt.Sprintf("  %s            Remote state not fetched recently\n",
+		staleStyle.Render(m.Config.Theme.Indicators.Stale)))
+	helpContent.WriteString(fmt.Sprintf("  %s            Stashed changes\n",
+		stashStyle.Render(m.Config.Theme.Indicators.Stash)))
+	for _, operation := range operationStyles {
+		helpContent.WriteString(fmt.Sprintf("  %s            %s\n",
+			lipgloss.NewStyle().Foreground(lipgloss.Color(operation.color)).Render(operation.indicator),
+			operation.description))
+	}
 	helpContent.WriteString(fmt.Sprintf("  %s            Untracked files\n",
 		untrackedStyle.Render(m.Config.Theme.Indicators.Untracked)))
 	helpContent.WriteString(fmt.Sprintf("  %s            Error accessing repository\n",
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbletea"
+)
+
+// RepositoriesChanged indicates that the watcher refreshed items after filesystem changes.
+type RepositoriesChanged struct {
+	Paths []string
+}
+
+// startWatcher starts live filesystem watching when it is enabled in the configuration.
+func (m Model) startWatcher() tea.Cmd {
+	if m.Watcher == nil {
+		return nil
+	}
+
+	m.Watcher.Start()
+	return m.waitForRepositoryChanges()
+}
+
+// waitForRepositoryChanges waits for the next batch of refreshed items.
+func (m Model) waitForRepositoryChanges() tea.Cmd {
+	changes := m.Watcher.Changes()
+	return func() tea.Msg {
+		return RepositoriesChanged{Paths: <-changes}
+	}
+}
+
+// handleRepositoriesChanged rebuilds the list, since worktrees may have been
+// added or removed, and waits for the next change.
+func (m Model) handleRepositoriesChanged(msg RepositoriesChanged) (tea.Model, tea.Cmd) {
+	m = m.syncItems()
+	return m, m.waitForRepositoryChanges()
+}
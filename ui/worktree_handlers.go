@@ -0,0 +1,456 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/logging"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/pages/worktree"
+	"github.com/jarmocluyse/git-dash/ui/pages/worktrees"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// Inputs of the new worktree dialog.
+const (
+	worktreeInputBranch = "branch" // Name of a new branch for the worktree
+	worktreeInputPath   = "path"   // Directory the worktree is created in
+)
+
+// WorktreeBranchesLoaded carries the branches the new worktree dialog offers.
+type WorktreeBranchesLoaded struct {
+	Path     string
+	Branches []repomanager.Branch
+	Err      error
+}
+
+// WorktreeAdded indicates that git worktree add finished.
+type WorktreeAdded struct {
+	RepoPath string
+	SubItem  *repomanager.SubItem
+	Err      error
+}
+
+// openWorktreeDialog starts creating a worktree for a repository by loading the
+// branches to pick from. On a worktree the worktree is created for its repository.
+// Linked worktrees tracked on their own list no worktrees and are ignored.
+func (m Model) openWorktreeDialog(item types.NavigableItem) (Model, tea.Cmd) {
+	if item.Type == "worktree" && item.ParentRepo != nil {
+		item = types.NavigableItem{Type: "repository", Repository: item.ParentRepo}
+	}
+	if item.Type != "repository" || item.Repository == nil || item.Repository.IsWorktree {
+		return m, nil
+	}
+
+	path := item.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.State = WorktreeView
+	m.SelectedNavItem = &item
+	m.WorktreeBranches = nil
+	m.WorktreeCursor = 0
+	m.WorktreeOffset = 0
+	m.WorktreeLoading = true
+	m.WorktreeRunning = false
+	m.WorktreeStatus = ""
+	m = m.cancelWorktreeInput()
+	return m, func() tea.Msg {
+		list, err := repoManager.ListBranches(path)
+		return WorktreeBranchesLoaded{Path: path, Branches: list, Err: err}
+	}
+}
+
+// closeWorktreeDialog returns to the list without creating a worktree.
+func (m Model) closeWorktreeDialog() Model {
+	m.State = ListView
+	m.SelectedNavItem = nil
+	return m
+}
+
+// handleWorktreeBranchesLoaded stores the branches if the dialog still shows the same repository.
+func (m Model) handleWorktreeBranchesLoaded(msg WorktreeBranchesLoaded) (tea.Model, tea.Cmd) {
+	if m.State != WorktreeView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.Path {
+		return m, nil
+	}
+
+	m.WorktreeLoading = false
+	if msg.Err != nil {
+		m.WorktreeStatus = "Failed to list branches: " + msg.Err.Error()
+		return m, nil
+	}
+	m.WorktreeBranches = msg.Branches
+	return m.moveWorktreeCursor(0), nil
+}
+
+// moveWorktreeCursor moves the branch selection of the new worktree dialog by delta.
+func (m Model) moveWorktreeCursor(delta int) Model {
+	m.WorktreeCursor, m.WorktreeOffset = scrollWindow(m.WorktreeCursor, m.WorktreeOffset, delta, len(m.WorktreeBranches), worktree.VisibleRows(m.Height))
+	return m
+}
+
+// selectedWorktreeBranch returns the branch under the cursor.
+func (m Model) selectedWorktreeBranch() (repomanager.Branch, bool) {
+	if m.SelectedNavItem == nil || m.WorktreeCursor >= len(m.WorktreeBranches) {
+		return repomanager.Branch{}, false
+	}
+	return m.WorktreeBranches[m.WorktreeCursor], true
+}
+
+// newWorktree describes the worktree the dialog would create for the selected branch.
+func (m Model) newWorktree() (repomanager.NewWorktree, bool) {
+	branch, ok := m.selectedWorktreeBranch()
+	if !ok {
+		return repomanager.NewWorktree{}, false
+	}
+	return repomanager.NewWorktree{Path: strings.TrimSpace(m.WorktreeInput), Branch: branch, NewBranch: m.WorktreeNewBranch}, true
+}
+
+// useWorktreeBranch picks the selected branch for the worktree and proposes its path.
+// A branch can only be checked out in one worktree at a time.
+func (m Model) useWorktreeBranch() Model {
+	branch, ok := m.selectedWorktreeBranch()
+	if !ok {
+		return m
+	}
+	if branch.Worktree != "" {
+		m.WorktreeStatus = fmt.Sprintf("%s is checked out in %s", branch.Name, branch.Worktree)
+		return m
+	}
+
+	m.WorktreeStatus = ""
+	m.WorktreeNewBranch = ""
+	return m.startWorktreePathInput()
+}
+
+// startWorktreeBranchInput starts typing the name of a new branch starting at the selected branch.
+func (m Model) startWorktreeBranchInput() Model {
+	if _, ok := m.selectedWorktreeBranch(); !ok {
+		return m
+	}
+
+	m.WorktreeStatus = ""
+	m.WorktreeInputAction = worktreeInputBranch
+	m.WorktreeInput = ""
+	return m
+}
+
+// startWorktreePathInput proposes the worktree path from the configured template
+// and lets it be edited.
+func (m Model) startWorktreePathInput() Model {
+	wt, ok := m.newWorktree()
+	if !ok {
+		return m
+	}
+
+	m.WorktreeInputAction = worktreeInputPath
+	template := m.Config.Worktree.PathTemplateFor(m.SelectedNavItem.Repository.IsBare)
+	m.WorktreeInput = repomanager.WorktreePath(template, m.SelectedNavItem.Path(), wt.LocalBranch())
+	return m
+}
+
+// editWorktreeInput applies a key press to the branch name or path being typed.
+// Branch names cannot contain spaces, so those only reach the path.
+func (m Model) editWorktreeInput(msg tea.KeyMsg) Model {
+	if msg.Alt {
+		return m
+	}
+
+	switch msg.Type {
+	case tea.KeyRunes:
+		m.WorktreeInput += string(msg.Runes)
+	case tea.KeySpace:
+		if m.WorktreeInputAction == worktreeInputPath {
+			m.WorktreeInput += " "
+		}
+	case tea.KeyBackspace:
+		if runes := []rune(m.WorktreeInput); len(runes) > 0 {
+			m.WorktreeInput = string(runes[:len(runes)-1])
+		}
+	}
+	return m
+}
+
+// cancelWorktreeInput stops typing and returns to picking a branch.
+func (m Model) cancelWorktreeInput() Model {
+	m.WorktreeInputAction = ""
+	m.WorktreeInput = ""
+	m.WorktreeNewBranch = ""
+	return m
+}
+
+// submitWorktreeInput moves from the new branch name to the path, or creates the
+// worktree once the path is confirmed.
+func (m Model) submitWorktreeInput() (Model, tea.Cmd) {
+	switch m.WorktreeInputAction {
+	case worktreeInputBranch:
+		name := strings.TrimSpace(m.WorktreeInput)
+		if name == "" {
+			return m, nil
+		}
+		m.WorktreeNewBranch = name
+		return m.startWorktreePathInput(), nil
+	case worktreeInputPath:
+		return m.addWorktree()
+	}
+	return m, nil
+}
+
+// addWorktree runs git worktree add in the background. Only one runs at a time.
+func (m Model) addWorktree() (Model, tea.Cmd) {
+	wt, ok := m.newWorktree()
+	if !ok || wt.Path == "" || m.WorktreeRunning {
+		return m, nil
+	}
+
+	repoPath := m.SelectedNavItem.Path()
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.WorktreeRunning = true
+	m.WorktreeStatus = "Adding worktree..."
+	return m, func() tea.Msg {
+		subItem, err := repoManager.AddWorktree(repoPath, wt)
+		return WorktreeAdded{RepoPath: repoPath, SubItem: subItem, Err: err}
+	}
+}
+
+// handleWorktreeAdded shows why adding the worktree failed, or returns to the list
+// with the new worktree selected.
+func (m Model) handleWorktreeAdded(msg WorktreeAdded) (tea.Model, tea.Cmd) {
+	m.WorktreeRunning = false
+	if m.State != WorktreeView || m.SelectedNavItem == nil || m.SelectedNavItem.Path() != msg.RepoPath {
+		m.NavItemsNeedSync = true
+		return m, nil
+	}
+
+	if msg.Err != nil {
+		logging.Get().Error("adding worktree failed", "path", msg.RepoPath, "error", msg.Err)
+		m.WorktreeStatus = "Failed to add worktree: " + msg.Err.Error()
+		return m, nil
+	}
+
+	m = m.closeWorktreeDialog().cancelWorktreeInput()
+	m.NavItemsNeedSync = true
+	for i, item := range m.getNavigableItems() {
+		if item.Path() == msg.SubItem.Path {
+			m.Cursor = i
+			break
+		}
+	}
+
+	visibleItems := m.getVisibleItemCount()
+	if m.Cursor < m.ScrollOffset {
+		m.ScrollOffset = m.Cursor
+	} else if m.Cursor >= m.ScrollOffset+visibleItems {
+		m.ScrollOffset = m.Cursor - visibleItems + 1
+	}
+	return m.requestRefresh()
+}
+
+// WorktreeActionComplete indicates that removing, pruning, locking or unlocking finished.
+type WorktreeActionComplete struct {
+	RepoPath string
+	Action   string // "remove", "prune", "lock" or "unlock"
+	Result   string // Description of the change, shown when it succeeded
+	Err      error
+}
+
+// openWorktreeList shows the linked worktrees of a repository, or of the
+// repository a worktree belongs to with that worktree selected.
+func (m Model) openWorktreeList(item types.NavigableItem) Model {
+	repo := item.Repository
+	if item.Type == "worktree" {
+		repo = item.ParentRepo
+	}
+	if repo == nil {
+		return m
+	}
+
+	m.WorktreeListReturnState = m.State
+	m.State = WorktreeListView
+	m.WorktreeRepo = repo
+	m.WorktreeListCursor = 0
+	m.WorktreeListOffset = 0
+	m.WorktreeListStatus = ""
+	m.WorktreeRemoveConfirm = false
+	m = m.cancelWorktreeLock()
+	for i, subItem := range repo.SubItems {
+		if subItem.Path == item.Path() {
+			m.WorktreeListCursor = i
+		}
+	}
+	return m.moveWorktreeListCursor(0)
+}
+
+// closeWorktreeList returns to the view the worktree list was opened from.
+func (m Model) closeWorktreeList() Model {
+	m.State = m.WorktreeListReturnState
+	m.WorktreeRepo = nil
+	return m
+}
+
+// worktreeListItems returns the linked worktrees shown on the worktree list.
+func (m Model) worktreeListItems() []*repomanager.SubItem {
+	if m.WorktreeRepo == nil {
+		return nil
+	}
+	return m.WorktreeRepo.SubItems
+}
+
+// moveWorktreeListCursor moves the selection by delta worktrees.
+func (m Model) moveWorktreeListCursor(delta int) Model {
+	m.WorktreeListCursor, m.WorktreeListOffset = scrollWindow(m.WorktreeListCursor, m.WorktreeListOffset, delta, len(m.worktreeListItems()), worktrees.VisibleRows(m.Height))
+	return m
+}
+
+// selectedWorktree returns the worktree under the cursor.
+func (m Model) selectedWorktree() (*repomanager.SubItem, bool) {
+	items := m.worktreeListItems()
+	if m.WorktreeListCursor >= len(items) {
+		return nil, false
+	}
+	return items[m.WorktreeListCursor], true
+}
+
+// confirmWorktreeRemove asks for confirmation before removing the selected
+// worktree, since removing deletes its directory, warning when uncommitted changes
+// would be lost.
+func (m Model) confirmWorktreeRemove() Model {
+	worktree, ok := m.selectedWorktree()
+	if !ok {
+		return m
+	}
+
+	switch {
+	case worktree.Locked:
+		m.WorktreeListStatus = worktree.Name + " is locked, press L to unlock it first"
+		return m
+	case worktree.HasUncommitted || worktree.HasUntracked:
+		m.WorktreeListStatus = fmt.Sprintf("%s has uncommitted changes that will be lost. Press y to force remove, any other key to cancel", worktree.Name)
+	default:
+		m.WorktreeListStatus = fmt.Sprintf("Remove %s? Press y to confirm, any other key to cancel", worktree.Name)
+	}
+	m.WorktreeRemoveConfirm = true
+	return m
+}
+
+// handleWorktreeRemoveConfirm removes the selected worktree when the key is y. A
+// dirty worktree was confirmed with its warning, so it is force removed.
+func (m Model) handleWorktreeRemoveConfirm(keyStr string) (Model, tea.Cmd) {
+	m.WorktreeRemoveConfirm = false
+	m.WorktreeListStatus = ""
+
+	worktree, ok := m.selectedWorktree()
+	if keyStr != "y" || !ok {
+		return m, nil
+	}
+
+	path := worktree.Path
+	force := worktree.HasUncommitted || worktree.HasUntracked
+	return m.runWorktreeAction("remove", "Removed "+worktree.Name, func(repoManager *repomanager.RepoManager) error {
+		return repoManager.RemoveWorktree(path, force)
+	})
+}
+
+// pruneWorktrees removes the entries of worktrees whose directory is gone.
+func (m Model) pruneWorktrees() (Model, tea.Cmd) {
+	if m.WorktreeRepo == nil {
+		return m, nil
+	}
+
+	repoPath := m.WorktreeRepo.Path
+	return m.runWorktreeAction("prune", "Pruned stale worktrees", func(repoManager *repomanager.RepoManager) error {
+		return repoManager.PruneWorktrees(repoPath)
+	})
+}
+
+// toggleWorktreeLock unlocks the selected worktree, or starts typing the reason
+// for locking it.
+func (m Model) toggleWorktreeLock() (Model, tea.Cmd) {
+	worktree, ok := m.selectedWorktree()
+	if !ok {
+		return m, nil
+	}
+
+	if worktree.Locked {
+		path := worktree.Path
+		return m.runWorktreeAction("unlock", "Unlocked "+worktree.Name, func(repoManager *repomanager.RepoManager) error {
+			return repoManager.UnlockWorktree(path)
+		})
+	}
+
+	m.WorktreeListStatus = ""
+	m.WorktreeLocking = true
+	m.WorktreeLockInput = ""
+	return m, nil
+}
+
+// editWorktreeLockInput applies a key press to the lock reason being typed.
+func (m Model) editWorktreeLockInput(msg tea.KeyMsg) Model {
+	if msg.Alt {
+		return m
+	}
+
+	switch msg.Type {
+	case tea.KeyRunes:
+		m.WorktreeLockInput += string(msg.Runes)
+	case tea.KeySpace:
+		m.WorktreeLockInput += " "
+	case tea.KeyBackspace:
+		if runes := []rune(m.WorktreeLockInput); len(runes) > 0 {
+			m.WorktreeLockInput = string(runes[:len(runes)-1])
+		}
+	}
+	return m
+}
+
+// cancelWorktreeLock stops typing a lock reason without locking.
+func (m Model) cancelWorktreeLock() Model {
+	m.WorktreeLocking = false
+	m.WorktreeLockInput = ""
+	return m
+}
+
+// submitWorktreeLock locks the selected worktree with the typed reason, which may be empty.
+func (m Model) submitWorktreeLock() (Model, tea.Cmd) {
+	worktree, ok := m.selectedWorktree()
+	reason := strings.TrimSpace(m.WorktreeLockInput)
+	m = m.cancelWorktreeLock()
+	if !ok {
+		return m, nil
+	}
+
+	path := worktree.Path
+	return m.runWorktreeAction("lock", "Locked "+worktree.Name, func(repoManager *repomanager.RepoManager) error {
+		return repoManager.LockWorktree(path, reason)
+	})
+}
+
+// runWorktreeAction runs a worktree action of the shown repository in the background.
+func (m Model) runWorktreeAction(action, result string, op func(repoManager *repomanager.RepoManager) error) (Model, tea.Cmd) {
+	repoPath := m.WorktreeRepo.Path
+	repoManager := m.Dependencies.GetRepoManager()
+
+	m.WorktreeListStatus = fmt.Sprintf("Running %s...", action)
+	return m, func() tea.Msg {
+		return WorktreeActionComplete{RepoPath: repoPath, Action: action, Result: result, Err: op(repoManager)}
+	}
+}
+
+// handleWorktreeActionComplete reports the outcome of a worktree action and
+// refreshes, since worktrees may have been added to or dropped from the list.
+func (m Model) handleWorktreeActionComplete(msg WorktreeActionComplete) (tea.Model, tea.Cmd) {
+	m = m.syncItems()
+	if m.State == WorktreeListView && m.WorktreeRepo != nil && m.WorktreeRepo.Path == msg.RepoPath {
+		if msg.Err != nil {
+			logging.Get().Error("worktree action failed", "action", msg.Action, "path", msg.RepoPath, "error", msg.Err)
+			m.WorktreeListStatus = fmt.Sprintf("Worktree %s failed: %v", msg.Action, msg.Err)
+		} else {
+			m.WorktreeListStatus = msg.Result
+		}
+		m = m.moveWorktreeListCursor(0)
+	}
+
+	m, refresh := m.requestRefresh()
+	return m, refresh
+}
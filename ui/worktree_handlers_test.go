@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/jarmocluyse/git-dash/internal/config"
+	"github.com/jarmocluyse/git-dash/internal/repomanager"
+	"github.com/jarmocluyse/git-dash/ui/types"
+)
+
+// newWorktreeModel returns a model showing the new worktree dialog of a bare repository.
+func newWorktreeModel() Model {
+	item := types.NavigableItem{Type: "repository", Repository: &repomanager.RepoItem{Path: "/code/app.git", IsBare: true}}
+	return Model{
+		Dependencies:    stubDependencies{},
+		Config:          &config.Config{},
+		State:           WorktreeView,
+		SelectedNavItem: &item,
+		WorktreeBranches: []repomanager.Branch{
+			{Name: "main", Worktree: "/code/app.git/main"},
+			{Name: "feature"},
+			{Name: "origin/fix", Remote: true},
+		},
+	}
+}
+
+func TestUseWorktreeBranch(t *testing.T) {
+	m := newWorktreeModel()
+
+	if got := m.useWorktreeBranch(); got.WorktreeInputAction != "" || got.WorktreeStatus != "main is checked out in /code/app.git/main" {
+		t.Errorf("expected a checked out branch to be refused, got status %q", got.WorktreeStatus)
+	}
+
+	m = m.moveWorktreeCursor(2).useWorktreeBranch()
+	if m.WorktreeInputAction != worktreeInputPath || m.WorktreeInput != "/code/app.git/fix" {
+		t.Errorf("expected the path of the local fix branch, got %q", m.WorktreeInput)
+	}
+
+	m.Config.Worktree.PathTemplate = "{parent}/{name}-{branch}"
+	m = m.cancelWorktreeInput().moveWorktreeCursor(-1).useWorktreeBranch()
+	if m.WorktreeInput != "/code/app-feature" {
+		t.Errorf("path = %q, want the configured template", m.WorktreeInput)
+	}
+}
+
+func TestOpenWorktreeDialog(t *testing.T) {
+	clone := &repomanager.RepoItem{Path: "/code/app"}
+	linked := &repomanager.RepoItem{Path: "/code/app-fix", IsWorktree: true}
+	m := Model{Dependencies: stubDependencies{}, Config: &config.Config{}}
+
+	got, cmd := m.openWorktreeDialog(types.NavigableItem{Type: "worktree", ParentRepo: clone, WorktreeInfo: &repomanager.SubItem{Path: "/code/app-feature"}})
+	if cmd == nil || got.State != WorktreeView || got.SelectedNavItem.Path() != "/code/app" {
+		t.Fatal("expected a worktree row to open the dialog of its clone")
+	}
+
+	// Worktrees of a clone are proposed next to it instead of inside its working tree
+	got.WorktreeBranches = []repomanager.Branch{{Name: "feature"}}
+	got.WorktreeLoading = false
+	if got = got.useWorktreeBranch(); got.WorktreeInput != "/code/app-feature" {
+		t.Errorf("path = %q, want a sibling of the clone", got.WorktreeInput)
+	}
+
+	if got, cmd := m.openWorktreeDialog(types.NavigableItem{Type: "repository", Repository: linked}); cmd != nil || got.State == WorktreeView {
+		t.Error("expected a linked worktree tracked on its own to be ignored")
+	}
+}
+
+func TestSubmitWorktreeInput_NewBranch(t *testing.T) {
+	m := newWorktreeModel().moveWorktreeCursor(1).startWorktreeBranchInput()
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("top")},
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("ic")},
+	}
+	for _, key := range keys {
+		m = m.editWorktreeInput(key)
+	}
+
+	m, cmd := m.submitWorktreeInput()
+	if cmd != nil || m.WorktreeNewBranch != "topic" || m.WorktreeInputAction != worktreeInputPath {
+		t.Fatalf("expected to continue with the path of topic, got branch %q", m.WorktreeNewBranch)
+	}
+	if m.WorktreeInput != "/code/app.git/topic" {
+		t.Errorf("path = %q", m.WorktreeInput)
+	}
+
+	wt, _ := m.newWorktree()
+	if wt.Branch.Name != "feature" || wt.NewBranch != "topic" {
+		t.Errorf("unexpected worktree %+v", wt)
+	}
+}
+
+func TestHandleWorktreeAdded_Failure(t *testing.T) {
+	m := newWorktreeModel().moveWorktreeCursor(1).useWorktreeBranch()
+	m.WorktreeRunning = true
+
+	updated, cmd := m.handleWorktreeAdded(WorktreeAdded{RepoPath: "/code/app.git", Err: errors.New("already exists")})
+	got := updated.(Model)
+	if cmd != nil || got.State != WorktreeView || got.WorktreeRunning || got.WorktreeInputAction != worktreeInputPath {
+		t.Error("expected the dialog to stay open with the path after a failure")
+	}
+	if got.WorktreeStatus != "Failed to add worktree: already exists" {
+		t.Errorf("status = %q", got.WorktreeStatus)
+	}
+}
+
+// newWorktreeListModel returns a model showing the worktree list of a repository.
+func newWorktreeListModel() Model {
+	repo := &repomanager.RepoItem{Name: "app", Path: "/code/app.git", IsBare: true}
+	repo.SubItems = []*repomanager.SubItem{
+		{Name: "main", Path: "/code/app.git/main", ParentRepo: repo},
+		{Name: "wip", Path: "/code/app.git/wip", HasUntracked: true, ParentRepo: repo},
+		{Name: "usb", Path: "/media/usb/app", Locked: true, LockReason: "on a usb drive", ParentRepo: repo},
+	}
+	m := Model{Dependencies: stubDependencies{}, Config: &config.Config{}, State: ListView}
+	return m.openWorktreeList(types.NavigableItem{Type: "worktree", WorktreeInfo: repo.SubItems[1], ParentRepo: repo})
+}
+
+func TestConfirmWorktreeRemove(t *testing.T) {
+	m := newWorktreeListModel()
+	if m.State != WorktreeListView || m.WorktreeListCursor != 1 {
+		t.Fatalf("expected the list to open on the worktree, got cursor %d", m.WorktreeListCursor)
+	}
+
+	m = m.confirmWorktreeRemove()
+	if !m.WorktreeRemoveConfirm || m.WorktreeListStatus != "wip has uncommitted changes that will be lost. Press y to force remove, any other key to cancel" {
+		t.Errorf("expected a warning before force removing, got %q", m.WorktreeListStatus)
+	}
+	if _, cmd := m.handleWorktreeRemoveConfirm("y"); cmd == nil {
+		t.Error("expected y to remove the worktree")
+	}
+	m, cmd := m.handleWorktreeRemoveConfirm("n")
+	if m.WorktreeRemoveConfirm || cmd != nil {
+		t.Error("expected any key other than y to cancel")
+	}
+
+	locked := m.moveWorktreeListCursor(1).confirmWorktreeRemove()
+	if locked.WorktreeRemoveConfirm || locked.WorktreeListStatus != "usb is locked, press L to unlock it first" {
+		t.Errorf("expected a locked worktree to be refused, got %q", locked.WorktreeListStatus)
+	}
+}
+
+func TestToggleWorktreeLock(t *testing.T) {
+	m := newWorktreeListModel().moveWorktreeListCursor(-1)
+
+	m, cmd := m.toggleWorktreeLock()
+	if cmd != nil || !m.WorktreeLocking {
+		t.Fatal("expected locking to ask for a reason")
+	}
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("release")},
+		{Type: tea.KeySpace},
+		{Type: tea.KeyRunes, Runes: []rune("branch")},
+	}
+	for _, key := range keys {
+		m = m.editWorktreeLockInput(key)
+	}
+	if m.WorktreeLockInput != "release branch" {
+		t.Errorf("reason = %q", m.WorktreeLockInput)
+	}
+
+	m, cmd = m.submitWorktreeLock()
+	if cmd == nil || m.WorktreeLocking || m.WorktreeListStatus != "Running lock..." {
+		t.Errorf("expected the lock to run, got status %q", m.WorktreeListStatus)
+	}
+
+	// A locked worktree is unlocked right away
+	if _, cmd := m.moveWorktreeListCursor(2).toggleWorktreeLock(); cmd == nil {
+		t.Error("expected unlocking to run")
+	}
+}